@@ -0,0 +1,89 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+	"github.com/YOUR_USERNAME/tictactoe/game/solver"
+)
+
+// errAnalyzeArgs indicates analyze was given positional arguments
+var errAnalyzeArgs = errors.New(`usage: tictactoe analyze [-position "X.O/.X./..O x"]`)
+
+// runAnalyze implements `tictactoe analyze`: solve a position and every move from it
+// Without -position the empty board described by the board flags is analyzed
+func runAnalyze(args []string) error {
+	fs := flag.NewFlagSet("analyze", flag.ContinueOnError)
+	position := fs.String("position", "", "position string to analyze, e.g. \"X.O/.X./..O x\"")
+	config := boardFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		return errAnalyzeArgs
+	}
+
+	g, err := newGame(config(), "", *position)
+	if err != nil {
+		return err
+	}
+
+	s := solver.New()
+	result, err := s.Solve(g)
+	if err != nil {
+		return err
+	}
+	moves, err := s.Analyze(g)
+	if err != nil {
+		return err
+	}
+	displayAnalysis(g, result, moves)
+	return nil
+}
+
+// displayAnalysis prints the solved position followed by a table of every legal move
+func displayAnalysis(g game.Game, result solver.Result, moves []solver.MoveResult) {
+	fmt.Println("Position:", g)
+	displayBoard(g.Board)
+
+	if g.State != game.InProgress {
+		displayResult(g.State)
+		return
+	}
+
+	player := g.CurrentPlayer
+	fmt.Printf("%s to move: %s\n", player.Name(), describeOutcome(player, result.Value, result.Distance))
+	fmt.Print("Optimal moves:")
+	for _, m := range result.Moves {
+		fmt.Printf("  %d %d", m.Row, m.Col)
+	}
+	fmt.Println()
+	fmt.Println()
+
+	fmt.Printf("%-6s %s\n", "Move", "Result with best play")
+	for _, m := range moves {
+		fmt.Printf("%-6s %s\n", fmt.Sprintf("%d %d", m.Row, m.Col), describeOutcome(player, m.Value, m.Distance))
+	}
+}
+
+// describeOutcome phrases a solved value for player, e.g. "Player 1 (X) wins in 3 plies"
+func describeOutcome(player game.Player, value solver.Value, distance int) string {
+	switch value {
+	case solver.Win:
+		return fmt.Sprintf("%s wins in %s", player.Name(), plies(distance))
+	case solver.Loss:
+		return fmt.Sprintf("%s wins in %s", player.Other().Name(), plies(distance))
+	default:
+		return fmt.Sprintf("draw after %s", plies(distance))
+	}
+}
+
+// plies returns "1 ply" or "n plies"
+func plies(n int) string {
+	if n == 1 {
+		return "1 ply"
+	}
+	return fmt.Sprintf("%d plies", n)
+}
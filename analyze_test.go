@@ -0,0 +1,55 @@
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+	"github.com/YOUR_USERNAME/tictactoe/game/solver"
+)
+
+// TestRunAnalyze verifies the analyze subcommand accepts positions and rejects bad input
+func TestRunAnalyze(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr error
+	}{
+		{"Empty board", nil, nil},
+		{"Mid-game position", []string{"-position", "XX./.O./... o"}, nil},
+		{"Finished game", []string{"-position", "XXX/OO./... o"}, nil},
+		{"Impossible position", []string{"-position", "XX./.../... o"}, game.ErrInvalidMarkCount},
+		{"Board too large", []string{"-width", "4", "-height", "4", "-win", "4"}, solver.ErrTooLarge},
+		{"Positional argument", []string{"XX./.O./... o"}, errAnalyzeArgs},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := runAnalyze(tt.args); !errors.Is(err, tt.wantErr) {
+				t.Errorf("runAnalyze(%v) error = %v, want %v", tt.args, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestDescribeOutcome verifies solved values are phrased for the player to move
+func TestDescribeOutcome(t *testing.T) {
+	tests := []struct {
+		player   game.Player
+		value    solver.Value
+		distance int
+		want     string
+	}{
+		{game.Player1, solver.Win, 1, "Player 1 (X) wins in 1 ply"},
+		{game.Player1, solver.Loss, 4, "Player 2 (O) wins in 4 plies"},
+		{game.Player2, solver.Draw, 9, "draw after 9 plies"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := describeOutcome(tt.player, tt.value, tt.distance); got != tt.want {
+				t.Errorf("describeOutcome() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
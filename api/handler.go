@@ -0,0 +1,252 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+	"github.com/YOUR_USERNAME/tictactoe/validation"
+)
+
+// maxBodyBytes limits the size of request bodies
+const maxBodyBytes = 1 << 16
+
+// Request errors
+var (
+	// ErrBadRequest indicates a request body is not valid JSON for the endpoint
+	ErrBadRequest = errors.New("Invalid request body")
+
+	// ErrUnknownPlayer indicates a move named a player other than X or O
+	ErrUnknownPlayer = errors.New("Invalid player. Use X or O")
+)
+
+// createRequest is the optional body of POST /games
+// Omitted fields default to the classic 3x3 board
+type createRequest struct {
+	Width     int `json:"width"`
+	Height    int `json:"height"`
+	WinLength int `json:"win_length"`
+}
+
+// moveRequest is the body of POST /games/{id}/moves
+// Either Row and Col, or Input in the terminal's "row col" syntax, must be set
+type moveRequest struct {
+	Row    *int   `json:"row"`
+	Col    *int   `json:"col"`
+	Input  string `json:"input"`
+	Player string `json:"player"` // Optional: reject the move unless this mark is to move
+}
+
+// config returns the requested board configuration, defaulting omitted fields
+// to the classic board
+func (req createRequest) config() game.Config {
+	config := game.StandardConfig()
+	if req.Width != 0 {
+		config.Width = req.Width
+	}
+	if req.Height != 0 {
+		config.Height = req.Height
+	}
+	if req.WinLength != 0 {
+		config.WinLength = req.WinLength
+	}
+	return config
+}
+
+// listResponse is the body returned by GET /games
+type listResponse struct {
+	Games []Entry `json:"games"`
+}
+
+// errorResponse is the body returned for every failed request
+type errorResponse struct {
+	Code  string `json:"code"`
+	Error string `json:"error"`
+}
+
+// Handler serves the REST API for the games in a store
+type Handler struct {
+	store *Store
+	mux   *http.ServeMux
+}
+
+// NewHandler returns a handler serving the games in store
+func NewHandler(store *Store) *Handler {
+	h := &Handler{store: store, mux: http.NewServeMux()}
+	h.mux.HandleFunc("POST /games", h.createGame)
+	h.mux.HandleFunc("GET /games", h.listGames)
+	h.mux.HandleFunc("GET /games/{id}", h.getGame)
+	h.mux.HandleFunc("POST /games/{id}/moves", h.postMove)
+	h.mux.HandleFunc("GET /games/{id}/live", h.liveGame)
+	return h
+}
+
+// ServeHTTP implements http.Handler
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+// createGame handles POST /games
+func (h *Handler) createGame(w http.ResponseWriter, r *http.Request) {
+	var req createRequest
+	if err := decodeBody(w, r, &req, true); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	entry, err := h.store.Create(req.config())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.Header().Set("Location", "/games/"+entry.ID)
+	writeJSON(w, http.StatusCreated, entry)
+}
+
+// listGames handles GET /games
+func (h *Handler) listGames(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, listResponse{Games: h.store.List()})
+}
+
+// getGame handles GET /games/{id}
+func (h *Handler) getGame(w http.ResponseWriter, r *http.Request) {
+	entry, err := h.store.Get(r.PathValue("id"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, entry)
+}
+
+// postMove handles POST /games/{id}/moves
+func (h *Handler) postMove(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	entry, err := h.store.Get(id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var req moveRequest
+	if err := decodeBody(w, r, &req, false); err != nil {
+		writeError(w, err)
+		return
+	}
+	row, col, err := req.position(entry.Game.Board)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	player, err := req.player()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	entry, err = h.store.Move(id, player, row, col)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, entry)
+}
+
+// position returns the cell named by the request, validated against board's size
+func (req moveRequest) position(board game.Board) (int, int, error) {
+	if req.Input != "" {
+		return validation.ParseAndValidateInputSize(req.Input, board.Width(), board.Height())
+	}
+	if req.Row == nil || req.Col == nil {
+		return 0, 0, validation.ErrIncompleteInput
+	}
+	if err := validation.ValidateRangeSize(*req.Row, *req.Col, board.Width(), board.Height()); err != nil {
+		return 0, 0, err
+	}
+	return *req.Row, *req.Col, nil
+}
+
+// player returns the player named by the request, or nil if none was named
+func (req moveRequest) player() (*game.Player, error) {
+	return parsePlayer(req.Player)
+}
+
+// parsePlayer converts a mark into a player, or nil if mark is empty
+func parsePlayer(mark string) (*game.Player, error) {
+	var p game.Player
+	switch strings.ToUpper(mark) {
+	case "":
+		return nil, nil
+	case "X":
+		p = game.Player1
+	case "O":
+		p = game.Player2
+	default:
+		return nil, ErrUnknownPlayer
+	}
+	return &p, nil
+}
+
+// decodeBody parses the JSON request body into v
+// An empty body is accepted only if optional is true
+func decodeBody(w http.ResponseWriter, r *http.Request, v any, optional bool) error {
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	dec.DisallowUnknownFields()
+	err := dec.Decode(v)
+	switch {
+	case err == io.EOF && optional:
+		return nil
+	case err != nil:
+		return ErrBadRequest
+	}
+	return nil
+}
+
+// errorStatus maps an error to its HTTP status code and machine-readable code
+func errorStatus(err error) (int, string) {
+	switch {
+	case errors.Is(err, ErrGameNotFound):
+		return http.StatusNotFound, "not_found"
+	case errors.Is(err, game.ErrCellOccupied):
+		return http.StatusConflict, "cell_occupied"
+	case errors.Is(err, ErrGameOver):
+		return http.StatusConflict, "game_over"
+	case errors.Is(err, ErrNotYourTurn):
+		return http.StatusConflict, "not_your_turn"
+	case errors.Is(err, ErrSeatTaken):
+		return http.StatusConflict, "seat_taken"
+	case errors.Is(err, ErrGameSeated):
+		return http.StatusConflict, "game_seated"
+	case errors.Is(err, ErrNotSeated):
+		return http.StatusForbidden, "not_seated"
+	case errors.Is(err, game.ErrInvalidRange), errors.Is(err, validation.ErrInvalidRange):
+		return http.StatusUnprocessableEntity, "invalid_range"
+	case errors.Is(err, game.ErrInvalidConfig):
+		return http.StatusUnprocessableEntity, "invalid_config"
+	case errors.Is(err, validation.ErrInvalidFormat):
+		return http.StatusBadRequest, "invalid_format"
+	case errors.Is(err, validation.ErrIncompleteInput):
+		return http.StatusBadRequest, "incomplete_input"
+	case errors.Is(err, ErrUnknownPlayer):
+		return http.StatusBadRequest, "unknown_player"
+	case errors.Is(err, ErrBadRequest):
+		return http.StatusBadRequest, "bad_request"
+	default:
+		return http.StatusInternalServerError, "internal"
+	}
+}
+
+// writeError writes err as a JSON error body with its mapped status code
+func writeError(w http.ResponseWriter, err error) {
+	status, code := errorStatus(err)
+	writeJSON(w, status, errorResponse{Code: code, Error: err.Error()})
+}
+
+// writeJSON writes v as the JSON response body with the given status code
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
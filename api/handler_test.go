@@ -0,0 +1,187 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// do sends a request to h and returns the recorded response
+func do(h http.Handler, method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+// decodeEntry parses a game response body
+func decodeEntry(t *testing.T, rec *httptest.ResponseRecorder) Entry {
+	t.Helper()
+	var entry Entry
+	if err := json.Unmarshal(rec.Body.Bytes(), &entry); err != nil {
+		t.Fatalf("Response %q is not a game: %v", rec.Body.String(), err)
+	}
+	return entry
+}
+
+// TestCreateAndPlay verifies a game can be created, played and fetched over HTTP
+func TestCreateAndPlay(t *testing.T) {
+	h := NewHandler(NewStore())
+
+	rec := do(h, "POST", "/games", "")
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("POST /games status = %d, want %d", rec.Code, http.StatusCreated)
+	}
+	created := decodeEntry(t, rec)
+	if loc := rec.Header().Get("Location"); loc != "/games/"+created.ID {
+		t.Errorf("Location = %q, want /games/%s", loc, created.ID)
+	}
+
+	rec = do(h, "POST", "/games/"+created.ID+"/moves", `{"row":1,"col":1}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("POST move status = %d: %s", rec.Code, rec.Body)
+	}
+	rec = do(h, "POST", "/games/"+created.ID+"/moves", `{"input":"0 2","player":"O"}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("POST move status = %d: %s", rec.Code, rec.Body)
+	}
+
+	rec = do(h, "GET", "/games/"+created.ID, "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET game status = %d", rec.Code)
+	}
+	got := decodeEntry(t, rec).Game
+	if got.Board.GetCell(1, 1) != game.X || got.Board.GetCell(0, 2) != game.O {
+		t.Errorf("Board = %v, want X at 1 1 and O at 0 2", got.Board)
+	}
+	if got.CurrentPlayer != game.Player1 {
+		t.Errorf("CurrentPlayer = %v, want Player1", got.CurrentPlayer)
+	}
+}
+
+// TestCreateCustomBoard verifies omitted size fields default to the classic board
+func TestCreateCustomBoard(t *testing.T) {
+	h := NewHandler(NewStore())
+
+	rec := do(h, "POST", "/games", `{"width":5,"height":4}`)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("POST /games status = %d: %s", rec.Code, rec.Body)
+	}
+	want := game.Config{Width: 5, Height: 4, WinLength: 3}
+	if got := decodeEntry(t, rec).Game.Config; got != want {
+		t.Errorf("Config = %v, want %v", got, want)
+	}
+}
+
+// TestListGames verifies GET /games returns every game
+func TestListGames(t *testing.T) {
+	h := NewHandler(NewStore())
+	do(h, "POST", "/games", "")
+	do(h, "POST", "/games", "")
+
+	rec := do(h, "GET", "/games", "")
+	var list listResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &list); err != nil {
+		t.Fatalf("GET /games body %q: %v", rec.Body, err)
+	}
+	if len(list.Games) != 2 {
+		t.Errorf("GET /games returned %d games, want 2", len(list.Games))
+	}
+}
+
+// TestErrorResponses verifies each kind of error maps to its status code and error code
+func TestErrorResponses(t *testing.T) {
+	h := NewHandler(NewStore())
+	id := decodeEntry(t, do(h, "POST", "/games", "")).ID
+	do(h, "POST", "/games/"+id+"/moves", `{"row":1,"col":1}`)
+	moves := "/games/" + id + "/moves"
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       string
+		wantStatus int
+		wantCode   string
+	}{
+		{"Unknown game", "GET", "/games/missing", "", http.StatusNotFound, "not_found"},
+		{"Move on unknown game", "POST", "/games/missing/moves", `{"row":0,"col":0}`, http.StatusNotFound, "not_found"},
+		{"Cell occupied", "POST", moves, `{"row":1,"col":1}`, http.StatusConflict, "cell_occupied"},
+		{"Not your turn", "POST", moves, `{"row":0,"col":0,"player":"X"}`, http.StatusConflict, "not_your_turn"},
+		{"Out of range", "POST", moves, `{"row":3,"col":0}`, http.StatusUnprocessableEntity, "invalid_range"},
+		{"Input out of range", "POST", moves, `{"input":"0 9"}`, http.StatusUnprocessableEntity, "invalid_range"},
+		{"Invalid format", "POST", moves, `{"input":"a b"}`, http.StatusBadRequest, "invalid_format"},
+		{"Incomplete input", "POST", moves, `{"input":"1"}`, http.StatusBadRequest, "incomplete_input"},
+		{"Missing column", "POST", moves, `{"row":1}`, http.StatusBadRequest, "incomplete_input"},
+		{"Unknown player", "POST", moves, `{"row":0,"col":0,"player":"Z"}`, http.StatusBadRequest, "unknown_player"},
+		{"Malformed JSON", "POST", moves, `{"row":`, http.StatusBadRequest, "bad_request"},
+		{"Unknown field", "POST", moves, `{"square":4}`, http.StatusBadRequest, "bad_request"},
+		{"Invalid board", "POST", "/games", `{"width":2,"height":2}`, http.StatusUnprocessableEntity, "invalid_config"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := do(h, tt.method, tt.path, tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("Status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			var body errorResponse
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("Error body %q is not JSON: %v", rec.Body, err)
+			}
+			if body.Code != tt.wantCode {
+				t.Errorf("Code = %q, want %q", body.Code, tt.wantCode)
+			}
+			if body.Error == "" {
+				t.Error("Error message is empty")
+			}
+		})
+	}
+}
+
+// TestMoveOnSeatedGame verifies REST moves to a game with a seated live player
+// are refused with 409 game_seated, and allowed again once the seat is released
+func TestMoveOnSeatedGame(t *testing.T) {
+	store := NewStore()
+	h := NewHandler(store)
+	id := decodeEntry(t, do(h, "POST", "/games", "")).ID
+	release, err := store.Claim(id, game.Player2)
+	if err != nil {
+		t.Fatalf("Claim() returned error: %v", err)
+	}
+
+	rec := do(h, "POST", "/games/"+id+"/moves", `{"input":"1 1"}`)
+	if rec.Code != http.StatusConflict {
+		t.Errorf("Status = %d, want %d", rec.Code, http.StatusConflict)
+	}
+	var body errorResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("Error body %q is not JSON: %v", rec.Body, err)
+	}
+	if body.Code != "game_seated" || body.Error != ErrGameSeated.Error() {
+		t.Errorf("Error body = %+v, want code game_seated with %q", body, ErrGameSeated.Error())
+	}
+
+	release()
+	if rec := do(h, "POST", "/games/"+id+"/moves", `{"input":"1 1"}`); rec.Code != http.StatusOK {
+		t.Errorf("Status after release = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
+
+// TestMoveAfterGameOver verifies finished games reject moves with 409
+func TestMoveAfterGameOver(t *testing.T) {
+	h := NewHandler(NewStore())
+	id := decodeEntry(t, do(h, "POST", "/games", "")).ID
+	for _, input := range []string{"0 0", "1 0", "0 1", "1 1", "0 2"} {
+		do(h, "POST", "/games/"+id+"/moves", `{"input":"`+input+`"}`)
+	}
+
+	rec := do(h, "POST", "/games/"+id+"/moves", `{"input":"2 2"}`)
+	if rec.Code != http.StatusConflict || !strings.Contains(rec.Body.String(), "game_over") {
+		t.Errorf("Move after win = %d %s, want 409 game_over", rec.Code, rec.Body)
+	}
+}
@@ -0,0 +1,170 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// writeTimeout bounds how long a live update may take to send
+const writeTimeout = 10 * time.Second
+
+// ErrNotSeated indicates a spectator tried to move
+var ErrNotSeated = errors.New("Spectators cannot move. Connect with ?player=X or ?player=O to play")
+
+// Live message types
+const (
+	TypeHello    = "hello"
+	TypeState    = "state"
+	TypeGameOver = "game-over"
+	TypeError    = "error"
+)
+
+// Game results reported by game-over messages
+const (
+	ResultPlayer1Won = "player1_won"
+	ResultPlayer2Won = "player2_won"
+	ResultDraw       = "draw"
+)
+
+// LiveMessage is a message sent to live connections
+// Only the fields relevant to Type are set
+type LiveMessage struct {
+	Type   string     `json:"type"`
+	Player string     `json:"player,omitempty"` // hello: the seat of this connection
+	Game   *game.Game `json:"game,omitempty"`   // state: the current position
+	Result string     `json:"result,omitempty"` // game-over: how the game ended
+	Code   string     `json:"code,omitempty"`   // error: machine-readable error code
+	Error  string     `json:"error,omitempty"`  // error: why the move was rejected
+}
+
+// resultOf returns the game-over result for a finished state
+func resultOf(state game.GameState) string {
+	switch state {
+	case game.Player1Won:
+		return ResultPlayer1Won
+	case game.Player2Won:
+		return ResultPlayer2Won
+	default:
+		return ResultDraw
+	}
+}
+
+// liveGame handles GET /games/{id}/live
+func (h *Handler) liveGame(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	seat, err := parsePlayer(r.URL.Query().Get("player"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if seat != nil {
+		release, err := h.store.Claim(id, *seat)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		defer release()
+	}
+
+	entry, updates, cancel, err := h.store.Watch(id)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	defer cancel()
+
+	conn, err := websocket.Accept(w, r, nil)
+	if err != nil {
+		return // Accept has already written the error response
+	}
+	defer conn.CloseNow()
+
+	ctx := r.Context()
+	hello := LiveMessage{Type: TypeHello}
+	if seat != nil {
+		hello.Player = seat.GetMark().String()
+	}
+	if !sendLive(ctx, conn, hello) {
+		return
+	}
+
+	readDone := make(chan struct{})
+	go func() {
+		defer close(readDone)
+		h.readMoves(ctx, conn, id, seat)
+	}()
+
+	for {
+		if !sendLive(ctx, conn, LiveMessage{Type: TypeState, Game: &entry.Game}) {
+			return
+		}
+		if entry.Game.State != game.InProgress {
+			sendLive(ctx, conn, LiveMessage{Type: TypeGameOver, Result: resultOf(entry.Game.State)})
+			conn.Close(websocket.StatusNormalClosure, "game over")
+			return
+		}
+
+		var ok bool
+		select {
+		case entry, ok = <-updates:
+			if !ok {
+				conn.Close(websocket.StatusTryAgainLater, "fell behind on updates")
+				return
+			}
+		case <-readDone:
+			return
+		}
+	}
+}
+
+// readMoves plays each move received on conn for seat until the connection closes
+// Accepted moves reach every connection through the store's watchers;
+// rejected moves are reported to this connection only
+func (h *Handler) readMoves(ctx context.Context, conn *websocket.Conn, id string, seat *game.Player) {
+	for {
+		var raw json.RawMessage
+		if err := wsjson.Read(ctx, conn, &raw); err != nil {
+			return
+		}
+		if err := h.playLiveMove(id, seat, raw); err != nil {
+			_, code := errorStatus(err)
+			sendLive(ctx, conn, LiveMessage{Type: TypeError, Code: code, Error: err.Error()})
+		}
+	}
+}
+
+// playLiveMove applies a move received from a live connection
+func (h *Handler) playLiveMove(id string, seat *game.Player, raw json.RawMessage) error {
+	if seat == nil {
+		return ErrNotSeated
+	}
+	var req moveRequest
+	if err := json.Unmarshal(raw, &req); err != nil {
+		return ErrBadRequest
+	}
+	entry, err := h.store.Get(id)
+	if err != nil {
+		return err
+	}
+	row, col, err := req.position(entry.Game.Board)
+	if err != nil {
+		return err
+	}
+	_, err = h.store.MoveSeated(id, *seat, row, col)
+	return err
+}
+
+// sendLive writes msg to conn, returning false if the connection has failed
+func sendLive(ctx context.Context, conn *websocket.Conn, msg LiveMessage) bool {
+	ctx, cancel := context.WithTimeout(ctx, writeTimeout)
+	defer cancel()
+	return wsjson.Write(ctx, conn, msg) == nil
+}
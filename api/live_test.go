@@ -0,0 +1,232 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// startLive runs the API on an in-process server and creates a game
+func startLive(t *testing.T) (*httptest.Server, string) {
+	t.Helper()
+	srv := httptest.NewServer(NewHandler(NewStore()))
+	t.Cleanup(srv.Close)
+
+	resp, err := http.Post(srv.URL+"/games", "application/json", nil)
+	if err != nil {
+		t.Fatalf("POST /games returned error: %v", err)
+	}
+	resp.Body.Close()
+	return srv, strings.TrimPrefix(resp.Header.Get("Location"), "/games/")
+}
+
+// connect opens a live connection to game id, seated as player if set
+func connect(t *testing.T, srv *httptest.Server, id, player string) *websocket.Conn {
+	t.Helper()
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + "/games/" + id + "/live"
+	if player != "" {
+		url += "?player=" + player
+	}
+	conn, _, err := websocket.Dial(testContext(t), url, nil)
+	if err != nil {
+		t.Fatalf("Dial(%s) returned error: %v", url, err)
+	}
+	t.Cleanup(func() { conn.CloseNow() })
+
+	expectLive(t, conn, TypeHello)
+	expectLive(t, conn, TypeState)
+	return conn
+}
+
+// testContext returns a context that fails the test's reads instead of hanging
+func testContext(t *testing.T) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+// expectLive reads the next message and checks its type
+func expectLive(t *testing.T, conn *websocket.Conn, msgType string) LiveMessage {
+	t.Helper()
+	var msg LiveMessage
+	if err := wsjson.Read(testContext(t), conn, &msg); err != nil {
+		t.Fatalf("Read() returned error: %v", err)
+	}
+	if msg.Type != msgType {
+		t.Fatalf("Read() type = %q (%+v), want %q", msg.Type, msg, msgType)
+	}
+	return msg
+}
+
+// sendMove writes a move on conn
+func sendMove(t *testing.T, conn *websocket.Conn, row, col int) {
+	t.Helper()
+	if err := wsjson.Write(testContext(t), conn, map[string]int{"row": row, "col": col}); err != nil {
+		t.Fatalf("Write() returned error: %v", err)
+	}
+}
+
+// TestLiveGamePushesMovesToEveryone plays a game over live connections with a spectator watching
+func TestLiveGamePushesMovesToEveryone(t *testing.T) {
+	srv, id := startLive(t)
+	x := connect(t, srv, id, "X")
+	o := connect(t, srv, id, "O")
+	spectator := connect(t, srv, id, "")
+	everyone := []*websocket.Conn{x, o, spectator}
+
+	// X wins along the top row
+	moves := []struct {
+		conn     *websocket.Conn
+		row, col int
+	}{{x, 0, 0}, {o, 1, 0}, {x, 0, 1}, {o, 1, 1}, {x, 0, 2}}
+	for i, m := range moves {
+		sendMove(t, m.conn, m.row, m.col)
+		for _, conn := range everyone {
+			state := expectLive(t, conn, TypeState)
+			if state.Game.MoveCount != i+1 {
+				t.Fatalf("State after move %d has MoveCount %d", i+1, state.Game.MoveCount)
+			}
+		}
+	}
+
+	for _, conn := range everyone {
+		if msg := expectLive(t, conn, TypeGameOver); msg.Result != ResultPlayer1Won {
+			t.Errorf("Game-over result = %q, want %q", msg.Result, ResultPlayer1Won)
+		}
+	}
+}
+
+// TestLiveRejectsInvalidMoves verifies rejected moves are reported only to the sender
+func TestLiveRejectsInvalidMoves(t *testing.T) {
+	srv, id := startLive(t)
+	x := connect(t, srv, id, "X")
+	o := connect(t, srv, id, "O")
+	spectator := connect(t, srv, id, "")
+
+	tests := []struct {
+		name     string
+		conn     *websocket.Conn
+		row, col int
+		wantCode string
+	}{
+		{"Spectator move", spectator, 0, 0, "not_seated"},
+		{"Out of turn", o, 0, 0, "not_your_turn"},
+		{"Off the board", x, 3, 3, "invalid_range"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sendMove(t, tt.conn, tt.row, tt.col)
+			if msg := expectLive(t, tt.conn, TypeError); msg.Code != tt.wantCode {
+				t.Errorf("Error code = %q, want %q", msg.Code, tt.wantCode)
+			}
+		})
+	}
+
+	// The next accepted move is the first update anyone else sees
+	sendMove(t, x, 1, 1)
+	if msg := expectLive(t, o, TypeState); msg.Game.Board.GetCell(1, 1) != game.X {
+		t.Errorf("Board after move = %v, want X at 1 1", msg.Game.Board)
+	}
+}
+
+// TestLivePushesRESTMoves verifies moves posted over HTTP reach live connections
+func TestLivePushesRESTMoves(t *testing.T) {
+	srv, id := startLive(t)
+	spectator := connect(t, srv, id, "")
+
+	resp, err := http.Post(srv.URL+"/games/"+id+"/moves", "application/json", strings.NewReader(`{"input":"2 2"}`))
+	if err != nil {
+		t.Fatalf("POST move returned error: %v", err)
+	}
+	resp.Body.Close()
+
+	if msg := expectLive(t, spectator, TypeState); msg.Game.Board.GetCell(2, 2) != game.X {
+		t.Errorf("Board after move = %v, want X at 2 2", msg.Game.Board)
+	}
+}
+
+// TestLiveRefusesRESTMovesWhileSeated verifies REST moves cannot play for seated players
+func TestLiveRefusesRESTMovesWhileSeated(t *testing.T) {
+	srv, id := startLive(t)
+	connect(t, srv, id, "X")
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"No player", `{"input":"1 1"}`},
+		{"Seated player named", `{"input":"1 1","player":"X"}`},
+		{"Unseated player named", `{"input":"1 1","player":"O"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := http.Post(srv.URL+"/games/"+id+"/moves", "application/json", strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatalf("POST move returned error: %v", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusConflict {
+				t.Errorf("POST move status = %d, want %d", resp.StatusCode, http.StatusConflict)
+			}
+		})
+	}
+}
+
+// TestLiveSeatRequests verifies seats are exclusive and requests for unknown games fail
+func TestLiveSeatRequests(t *testing.T) {
+	srv, id := startLive(t)
+	connect(t, srv, id, "X")
+
+	tests := []struct {
+		name       string
+		path       string
+		wantStatus int
+	}{
+		{"Seat taken", "/games/" + id + "/live?player=X", http.StatusConflict},
+		{"Unknown player", "/games/" + id + "/live?player=Z", http.StatusBadRequest},
+		{"Unknown game", "/games/missing/live", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url := "ws" + strings.TrimPrefix(srv.URL, "http") + tt.path
+			_, resp, err := websocket.Dial(testContext(t), url, nil)
+			if err == nil {
+				t.Fatal("Dial() succeeded, want error")
+			}
+			if resp == nil || resp.StatusCode != tt.wantStatus {
+				t.Errorf("Dial() response = %v, want status %d", resp, tt.wantStatus)
+			}
+		})
+	}
+}
+
+// TestLiveSeatReleasedOnDisconnect verifies a player can reconnect to their seat
+func TestLiveSeatReleasedOnDisconnect(t *testing.T) {
+	srv, id := startLive(t)
+	x := connect(t, srv, id, "X")
+	x.Close(websocket.StatusNormalClosure, "")
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		url := "ws" + strings.TrimPrefix(srv.URL, "http") + "/games/" + id + "/live?player=X"
+		conn, _, err := websocket.Dial(testContext(t), url, nil)
+		if err == nil {
+			conn.CloseNow()
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Seat X was not released: %v", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
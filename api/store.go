@@ -0,0 +1,229 @@
+// Package api serves tic-tac-toe games over an HTTP/JSON REST API
+//
+// Endpoints:
+//
+//	POST /games               create a game; optional body {"width":3,"height":3,"win_length":3}
+//	GET  /games               list every game
+//	GET  /games/{id}          fetch one game
+//	POST /games/{id}/moves    play a move; body {"row":1,"col":1} or {"input":"1 1"}
+//	GET  /games/{id}/live     WebSocket of live updates; ?player=X or ?player=O takes a seat
+//
+// Games are returned as {"id":"1","game":{...}}, where "game" uses the save
+// file schema written by game.Save. Errors are returned as
+// {"code":"cell_occupied","error":"..."} with a status code that depends on
+// the kind of error; see errorStatus.
+//
+// Live connections receive, as JSON text messages:
+//
+//	{"type":"hello","player":"X"}                  seat taken; player is empty for spectators
+//	{"type":"state","game":{...}}                  on connect and after every accepted move
+//	{"type":"game-over","result":"player1_won"}   once the game finishes, before closing
+//	{"type":"error","code":"...","error":"..."}    a move sent on this connection was rejected
+//
+// Seated players send moves using the POST /games/{id}/moves body; moves made
+// through the REST endpoint are pushed to live connections too. While any
+// seat of a game is claimed, its REST moves are refused with 409 game_seated,
+// since the REST endpoint cannot tell who is posting.
+package api
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// Store errors
+var (
+	// ErrGameNotFound indicates no game has the requested ID
+	ErrGameNotFound = errors.New("Game not found")
+
+	// ErrGameOver indicates a move was sent to a finished game
+	ErrGameOver = errors.New("Game is already over")
+
+	// ErrNotYourTurn indicates a move named a player who is not to move
+	ErrNotYourTurn = errors.New("Not your turn. Wait for your opponent to move")
+
+	// ErrSeatTaken indicates another connection already plays the requested side
+	ErrSeatTaken = errors.New("That player's seat is already taken")
+
+	// ErrGameSeated indicates a REST move was posted to a game with seated live players
+	ErrGameSeated = errors.New("Moves for this game must be sent over its player WebSocket")
+)
+
+// watchBuffer is how many updates a watcher may fall behind before it is dropped
+const watchBuffer = 16
+
+// Entry is a stored game together with its ID
+type Entry struct {
+	ID   string    `json:"id"`
+	Game game.Game `json:"game"`
+}
+
+// Store holds games in memory, keyed by ID
+// It is safe for concurrent use
+type Store struct {
+	mu       sync.Mutex
+	games    map[string]game.Game
+	order    []string // IDs in creation order
+	nextID   int
+	watchers map[string]map[chan Entry]struct{}  // Update channels per game
+	seats    map[string]map[game.Player]struct{} // Claimed seats per game
+}
+
+// NewStore returns an empty store
+func NewStore() *Store {
+	return &Store{
+		games:    make(map[string]game.Game),
+		watchers: make(map[string]map[chan Entry]struct{}),
+		seats:    make(map[string]map[game.Player]struct{}),
+	}
+}
+
+// Create starts a new game with config and returns it with its ID
+func (s *Store) Create(config game.Config) (Entry, error) {
+	g, err := game.NewGameWithConfig(config)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextID++
+	id := strconv.Itoa(s.nextID)
+	s.games[id] = g
+	s.order = append(s.order, id)
+	return Entry{ID: id, Game: g}, nil
+}
+
+// Get returns the game with the given ID
+func (s *Store) Get(id string) (Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	g, ok := s.games[id]
+	if !ok {
+		return Entry{}, ErrGameNotFound
+	}
+	return Entry{ID: id, Game: g}, nil
+}
+
+// List returns every game in creation order
+func (s *Store) List() []Entry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entries := make([]Entry, 0, len(s.order))
+	for _, id := range s.order {
+		entries = append(entries, Entry{ID: id, Game: s.games[id]})
+	}
+	return entries
+}
+
+// Move plays row, col in the game with the given ID and returns the new position
+// If player is non-nil the move is rejected unless that player is to move
+// Returns ErrGameSeated while any seat of the game is claimed: those games are
+// played only through MoveSeated
+func (s *Store) Move(id string, player *game.Player, row, col int) (Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.seats[id]) > 0 {
+		return Entry{}, ErrGameSeated
+	}
+	return s.move(id, player, row, col)
+}
+
+// MoveSeated plays row, col for the holder of player's seat, claimed with Claim
+// Returns ErrNotYourTurn unless player is to move
+func (s *Store) MoveSeated(id string, player game.Player, row, col int) (Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.move(id, &player, row, col)
+}
+
+// move plays row, col in the game with the given ID
+// The caller must hold s.mu
+func (s *Store) move(id string, player *game.Player, row, col int) (Entry, error) {
+	g, ok := s.games[id]
+	if !ok {
+		return Entry{}, ErrGameNotFound
+	}
+	if g.State != game.InProgress {
+		return Entry{}, ErrGameOver
+	}
+	if player != nil && *player != g.CurrentPlayer {
+		return Entry{}, ErrNotYourTurn
+	}
+
+	next, err := g.MakeMove(row, col)
+	if err != nil {
+		return Entry{}, err
+	}
+	s.games[id] = next
+	entry := Entry{ID: id, Game: next}
+	s.notify(entry)
+	return entry, nil
+}
+
+// Watch returns the game with the given ID and a channel that receives the
+// game after every accepted move, until cancel is called
+// A watcher that falls too far behind has its channel closed
+func (s *Store) Watch(id string) (entry Entry, updates <-chan Entry, cancel func(), err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	g, ok := s.games[id]
+	if !ok {
+		return Entry{}, nil, nil, ErrGameNotFound
+	}
+
+	ch := make(chan Entry, watchBuffer)
+	if s.watchers[id] == nil {
+		s.watchers[id] = make(map[chan Entry]struct{})
+	}
+	s.watchers[id][ch] = struct{}{}
+
+	cancel = func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if _, ok := s.watchers[id][ch]; ok {
+			delete(s.watchers[id], ch)
+			close(ch)
+		}
+	}
+	return Entry{ID: id, Game: g}, ch, cancel, nil
+}
+
+// notify sends entry to every watcher of its game, dropping watchers that are full
+// The caller must hold s.mu
+func (s *Store) notify(entry Entry) {
+	for ch := range s.watchers[entry.ID] {
+		select {
+		case ch <- entry:
+		default:
+			delete(s.watchers[entry.ID], ch)
+			close(ch)
+		}
+	}
+}
+
+// Claim reserves player's seat in the game with the given ID until release is called
+// Returns ErrSeatTaken if the seat is already reserved
+func (s *Store) Claim(id string, player game.Player) (release func(), err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.games[id]; !ok {
+		return nil, ErrGameNotFound
+	}
+	if _, taken := s.seats[id][player]; taken {
+		return nil, ErrSeatTaken
+	}
+	if s.seats[id] == nil {
+		s.seats[id] = make(map[game.Player]struct{})
+	}
+	s.seats[id][player] = struct{}{}
+
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.seats[id], player)
+	}, nil
+}
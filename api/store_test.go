@@ -0,0 +1,181 @@
+package api
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// TestStoreCreateGetList verifies games are stored under distinct IDs and listed in creation order
+func TestStoreCreateGetList(t *testing.T) {
+	store := NewStore()
+	first, err := store.Create(game.StandardConfig())
+	if err != nil {
+		t.Fatalf("Create() returned error: %v", err)
+	}
+	second, err := store.Create(game.Config{Width: 4, Height: 4, WinLength: 3})
+	if err != nil {
+		t.Fatalf("Create() returned error: %v", err)
+	}
+	if first.ID == second.ID {
+		t.Fatalf("Create() returned duplicate ID %q", first.ID)
+	}
+
+	got, err := store.Get(second.ID)
+	if err != nil {
+		t.Fatalf("Get() returned error: %v", err)
+	}
+	if got.Game.Board.Width() != 4 {
+		t.Errorf("Get() board width = %d, want 4", got.Game.Board.Width())
+	}
+
+	list := store.List()
+	if len(list) != 2 || list[0].ID != first.ID || list[1].ID != second.ID {
+		t.Errorf("List() = %+v, want games %s and %s in order", list, first.ID, second.ID)
+	}
+}
+
+// TestStoreErrors verifies the store rejects invalid requests
+func TestStoreErrors(t *testing.T) {
+	store := NewStore()
+	entry, _ := store.Create(game.StandardConfig())
+	o := game.Player2
+
+	tests := []struct {
+		name    string
+		id      string
+		player  *game.Player
+		row     int
+		col     int
+		wantErr error
+	}{
+		{"Unknown game", "missing", nil, 0, 0, ErrGameNotFound},
+		{"Wrong player", entry.ID, &o, 0, 0, ErrNotYourTurn},
+		{"Off the board", entry.ID, nil, 3, 0, game.ErrInvalidRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := store.Move(tt.id, tt.player, tt.row, tt.col); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Move() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := store.Create(game.Config{Width: 2, Height: 2, WinLength: 3}); !errors.Is(err, game.ErrInvalidConfig) {
+		t.Errorf("Create() error = %v, want ErrInvalidConfig", err)
+	}
+}
+
+// TestStoreRejectsMovesAfterGameOver verifies a finished game cannot be played on
+func TestStoreRejectsMovesAfterGameOver(t *testing.T) {
+	store := NewStore()
+	entry, _ := store.Create(game.StandardConfig())
+	for _, m := range [][2]int{{0, 0}, {1, 0}, {0, 1}, {1, 1}, {0, 2}} {
+		if _, err := store.Move(entry.ID, nil, m[0], m[1]); err != nil {
+			t.Fatalf("Move(%d, %d) returned error: %v", m[0], m[1], err)
+		}
+	}
+	if _, err := store.Move(entry.ID, nil, 2, 2); !errors.Is(err, ErrGameOver) {
+		t.Errorf("Move() after win error = %v, want ErrGameOver", err)
+	}
+}
+
+// TestStoreSeatedGames verifies unseated moves are refused while a seat is claimed
+// and allowed again once every seat is released
+func TestStoreSeatedGames(t *testing.T) {
+	store := NewStore()
+	entry, _ := store.Create(game.StandardConfig())
+	x := game.Player1
+	release, err := store.Claim(entry.ID, x)
+	if err != nil {
+		t.Fatalf("Claim() returned error: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		player *game.Player
+	}{
+		{"No player", nil},
+		{"Seated player named", &x},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := store.Move(entry.ID, tt.player, 1, 1); !errors.Is(err, ErrGameSeated) {
+				t.Errorf("Move() error = %v, want ErrGameSeated", err)
+			}
+		})
+	}
+
+	if _, err := store.MoveSeated(entry.ID, x, 1, 1); err != nil {
+		t.Fatalf("MoveSeated() returned error: %v", err)
+	}
+	if _, err := store.MoveSeated(entry.ID, x, 0, 0); !errors.Is(err, ErrNotYourTurn) {
+		t.Errorf("MoveSeated() out of turn error = %v, want ErrNotYourTurn", err)
+	}
+
+	release()
+	if _, err := store.Move(entry.ID, nil, 0, 0); err != nil {
+		t.Errorf("Move() after release returned error: %v", err)
+	}
+}
+
+// TestStoreConcurrentMoves verifies racing players cannot both claim the same cell
+func TestStoreConcurrentMoves(t *testing.T) {
+	store := NewStore()
+	entry, _ := store.Create(game.StandardConfig())
+
+	const racers = 16
+	var wg sync.WaitGroup
+	errs := make(chan error, racers)
+	for range racers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := store.Move(entry.ID, nil, 1, 1)
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	succeeded := 0
+	for err := range errs {
+		if err == nil {
+			succeeded++
+		} else if !errors.Is(err, game.ErrCellOccupied) {
+			t.Errorf("Move() error = %v, want nil or ErrCellOccupied", err)
+		}
+	}
+	if succeeded != 1 {
+		t.Errorf("%d concurrent moves succeeded, want 1", succeeded)
+	}
+
+	got, _ := store.Get(entry.ID)
+	if got.Game.MoveCount != 1 {
+		t.Errorf("MoveCount = %d, want 1", got.Game.MoveCount)
+	}
+}
+
+// TestStoreWatch verifies watchers receive accepted moves and stop after cancel
+func TestStoreWatch(t *testing.T) {
+	store := NewStore()
+	entry, _ := store.Create(game.StandardConfig())
+	_, updates, cancel, err := store.Watch(entry.ID)
+	if err != nil {
+		t.Fatalf("Watch() returned error: %v", err)
+	}
+
+	store.Move(entry.ID, nil, 1, 1)
+	if got := <-updates; got.Game.MoveCount != 1 {
+		t.Errorf("Update MoveCount = %d, want 1", got.Game.MoveCount)
+	}
+
+	cancel()
+	if _, ok := <-updates; ok {
+		t.Error("Updates channel still open after cancel")
+	}
+	cancel()
+}
@@ -0,0 +1,165 @@
+package ai
+
+import "github.com/YOUR_USERNAME/tictactoe/game"
+
+// DefaultHeuristicDepth is the number of plies searched by the heuristic strategy
+const DefaultHeuristicDepth = 2
+
+// Evaluation weights
+// A line holding only one player's marks is worth lineWeightBase times more
+// for every extra mark it holds. Evaluations are clamped to maxEvaluation so
+// that even the slowest win, (winScore-depth)*heuristicWinScale, outweighs any
+// sum of line scores on boards with long lines, while staying within a 32-bit int
+const (
+	lineWeightBase    = 10
+	heuristicWinScale = 1 << 24
+	maxEvaluation     = heuristicWinScale - 1
+)
+
+// HeuristicStrategy runs a depth-limited minimax and scores the
+// frontier positions by counting open lines
+type HeuristicStrategy struct {
+	Config game.Config // Board dimensions and win length
+	Depth  int         // Plies to search before evaluating
+}
+
+// NewHeuristic returns a heuristic strategy searching depth plies
+func NewHeuristic(config game.Config, depth int) HeuristicStrategy {
+	return HeuristicStrategy{Config: config, Depth: depth}
+}
+
+// ChooseMove returns the best move found within Depth plies
+func (s HeuristicStrategy) ChooseMove(board game.Board, player game.Player) (int, int, error) {
+	if isFinished(s.Config, board) {
+		return 0, 0, ErrGameOver
+	}
+
+	g := gameFor(s.Config, board, player)
+	lines := s.Config.Lines()
+	best := game.Position{Row: -1, Col: -1}
+	bestScore := -infinity * heuristicWinScale
+	for _, cell := range candidateMoves(board) {
+		next, err := g.MakeMove(cell.Row, cell.Col)
+		if err != nil {
+			continue
+		}
+		score := s.search(next, player, lines, s.Depth-1)
+		if score > bestScore {
+			bestScore = score
+			best = cell
+		}
+	}
+	return best.Row, best.Col, nil
+}
+
+// search returns the depth-limited minimax value of g for player
+func (s HeuristicStrategy) search(g game.Game, player game.Player, lines [][]game.Position, depth int) int {
+	if g.State != game.InProgress {
+		return terminalScore(g.State, player, s.Depth-depth) * heuristicWinScale
+	}
+	if depth <= 0 {
+		score := evaluate(g.Board, player, lines)
+		if s.Config.Variant == game.Misere {
+			// Lines are liabilities when completing one loses
+			score = -score
+		}
+		return score
+	}
+
+	maximizing := g.CurrentPlayer == player
+	best := infinity * heuristicWinScale
+	if maximizing {
+		best = -best
+	}
+	for _, cell := range candidateMoves(g.Board) {
+		next, err := g.MakeMove(cell.Row, cell.Col)
+		if err != nil {
+			continue
+		}
+		score := s.search(next, player, lines, depth-1)
+		if maximizing {
+			best = max(best, score)
+		} else {
+			best = min(best, score)
+		}
+	}
+	return best
+}
+
+// candidateMoves returns the empty cells worth searching
+// On boards larger than the classic 3x3 only cells next to an existing mark
+// are considered, which keeps the search tractable on Gomoku-sized boards
+func candidateMoves(board game.Board) []game.Position {
+	empty := emptyCells(board)
+	if board.Width()*board.Height() <= game.BOARD_SIZE*game.BOARD_SIZE {
+		return empty
+	}
+
+	var near []game.Position
+	for _, cell := range empty {
+		if hasOccupiedNeighbour(board, cell) {
+			near = append(near, cell)
+		}
+	}
+	if len(near) == 0 {
+		// Empty board: open in the center
+		return []game.Position{{Row: board.Height() / 2, Col: board.Width() / 2}}
+	}
+	return near
+}
+
+// hasOccupiedNeighbour returns true if any of the eight cells around cell holds a mark
+func hasOccupiedNeighbour(board game.Board, cell game.Position) bool {
+	for dRow := -1; dRow <= 1; dRow++ {
+		for dCol := -1; dCol <= 1; dCol++ {
+			row, col := cell.Row+dRow, cell.Col+dCol
+			if board.InBounds(row, col) && board.GetCell(row, col).IsOccupied() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// evaluate scores a non-terminal board from player's point of view, between
+// -maxEvaluation and maxEvaluation
+func evaluate(board game.Board, player game.Player, lines [][]game.Position) int {
+	mine, theirs := player.GetMark(), player.Other().GetMark()
+	var score int64
+	for _, line := range lines {
+		score += int64(lineScore(board, line, mine)) - int64(lineScore(board, line, theirs))
+	}
+	return int(min(max(score, -maxEvaluation), maxEvaluation))
+}
+
+// lineScore weights a line by how many of mark's cells it holds,
+// or returns zero if the opponent has blocked it
+func lineScore(board game.Board, line []game.Position, mark game.Cell) int {
+	count := 0
+	for _, cell := range line {
+		switch board.GetCell(cell.Row, cell.Col) {
+		case mark:
+			count++
+		case game.Empty:
+		default:
+			return 0
+		}
+	}
+	return lineWeight(count)
+}
+
+// lineWeight returns the score of an unblocked line holding count marks,
+// at most maxEvaluation
+func lineWeight(count int) int {
+	if count == 0 {
+		return 0
+	}
+	weight := 1
+	for i := 1; i < count; i++ {
+		if weight > maxEvaluation/lineWeightBase {
+			return maxEvaluation
+		}
+		weight *= lineWeightBase
+	}
+	return weight
+}
@@ -0,0 +1,139 @@
+package ai
+
+import (
+	"testing"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// TestEvaluate verifies open lines are scored from the player's point of view
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		name   string
+		board  game.Board
+		player game.Player
+		want   int
+	}{
+		{
+			name:   "Empty board is balanced",
+			board:  game.NewBoard(),
+			player: game.Player1,
+			want:   0,
+		},
+		{
+			name: "Center mark opens four lines",
+			board: game.Board{
+				{game.Empty, game.Empty, game.Empty},
+				{game.Empty, game.X, game.Empty},
+				{game.Empty, game.Empty, game.Empty},
+			},
+			player: game.Player1,
+			want:   4 * lineWeight(1),
+		},
+		{
+			name: "Opponent's view is negated",
+			board: game.Board{
+				{game.Empty, game.Empty, game.Empty},
+				{game.Empty, game.X, game.Empty},
+				{game.Empty, game.Empty, game.Empty},
+			},
+			player: game.Player2,
+			want:   -4 * lineWeight(1),
+		},
+		{
+			name: "Shared row is blocked for both players",
+			board: game.Board{
+				{game.X, game.O, game.Empty},
+				{game.Empty, game.Empty, game.Empty},
+				{game.Empty, game.Empty, game.Empty},
+			},
+			player: game.Player1,
+			// X keeps column 0 and the main diagonal, O keeps column 1
+			want: (2 * lineWeight(1)) - lineWeight(1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := evaluate(tt.board, tt.player, game.StandardConfig().Lines()); got != tt.want {
+				t.Errorf("evaluate() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestLineWeight verifies fuller lines are worth exponentially more
+func TestLineWeight(t *testing.T) {
+	tests := []struct {
+		count int
+		want  int
+	}{
+		{0, 0},
+		{1, 1},
+		{2, lineWeightBase},
+		{4, lineWeightBase * lineWeightBase * lineWeightBase},
+		{game.MaxDimension, maxEvaluation},
+	}
+
+	for _, tt := range tests {
+		if got := lineWeight(tt.count); got != tt.want {
+			t.Errorf("lineWeight(%d) = %d, want %d", tt.count, got, tt.want)
+		}
+	}
+}
+
+// TestCandidateMoves verifies large boards only search next to existing marks
+func TestCandidateMoves(t *testing.T) {
+	if got := candidateMoves(game.NewBoard()); len(got) != 9 {
+		t.Errorf("candidateMoves(3x3) returned %d cells, want all 9", len(got))
+	}
+
+	empty := game.NewBoardSize(15, 15)
+	if got := candidateMoves(empty); len(got) != 1 || got[0] != (game.Position{Row: 7, Col: 7}) {
+		t.Errorf("candidateMoves(empty 15x15) = %v, want the center", got)
+	}
+
+	corner := empty.SetCell(0, 0, game.X)
+	if got := candidateMoves(corner); len(got) != 3 {
+		t.Errorf("candidateMoves(corner mark) returned %d cells, want 3 neighbours", len(got))
+	}
+}
+
+// TestHeuristicOnLargeBoard verifies the heuristic blocks an open four in Gomoku
+func TestHeuristicOnLargeBoard(t *testing.T) {
+	config := game.Config{Width: 15, Height: 15, WinLength: 5}
+	board := game.NewBoardSize(15, 15)
+	for col := 3; col < 7; col++ {
+		board = board.SetCell(7, col, game.X)
+	}
+	board = board.SetCell(7, 2, game.O).SetCell(6, 6, game.O).SetCell(8, 8, game.O)
+
+	row, col, err := NewHeuristic(config, DefaultHeuristicDepth).ChooseMove(board, game.Player2)
+	if err != nil {
+		t.Fatalf("ChooseMove() returned error: %v", err)
+	}
+	if row != 7 || col != 7 {
+		t.Errorf("ChooseMove() = (%d, %d), want block at (7, 7)", row, col)
+	}
+}
+
+// TestHeuristicTakesWinWithLongLines verifies an immediate win outscores any
+// evaluation, even when several nearly complete long lines are worth more than
+// a win would be without clamping
+func TestHeuristicTakesWinWithLongLines(t *testing.T) {
+	config := game.Config{Width: 12, Height: 12, WinLength: 10}
+	board := game.NewBoardSize(12, 12)
+	for _, row := range []int{1, 5, 9} {
+		for col := 0; col < 9; col++ {
+			board = board.SetCell(row, col, game.X)
+		}
+	}
+
+	row, col, err := NewHeuristic(config, DefaultHeuristicDepth).ChooseMove(board, game.Player1)
+	if err != nil {
+		t.Fatalf("ChooseMove() returned error: %v", err)
+	}
+	if !config.CheckWin(board.SetCell(row, col, game.X), game.X) {
+		t.Errorf("ChooseMove() = (%d, %d), want a move completing a line of 10", row, col)
+	}
+}
@@ -0,0 +1,118 @@
+// Package ai provides computer opponents for the tic-tac-toe game
+package ai
+
+import (
+	"errors"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// Score bounds used by the minimax search
+// A win is worth winScore minus the search depth so faster wins
+// (and slower losses) are preferred
+const (
+	winScore  = 100
+	drawScore = 0
+	infinity  = winScore + 1
+)
+
+// ErrGameOver is returned when a move is requested for a finished game
+var ErrGameOver = errors.New("game is already over")
+
+// BestMove searches the full game tree with minimax and alpha-beta pruning
+// and returns the optimal (row, col) for g.CurrentPlayer
+// Ties are broken in row-major order so the result is deterministic
+// The search is exponential in the number of empty cells, so it is only
+// practical on small boards (see MaxPerfectCells)
+// Returns ErrGameOver if the game is not in progress
+func BestMove(g game.Game) (int, int, error) {
+	if g.State != game.InProgress {
+		return 0, 0, ErrGameOver
+	}
+
+	bestRow, bestCol := -1, -1
+	bestScore := -infinity
+	alpha := -infinity
+
+	for _, cell := range emptyCells(g.Board) {
+		next, err := g.MakeMove(cell.Row, cell.Col)
+		if err != nil {
+			continue
+		}
+		score := minimax(next, g.CurrentPlayer, 1, alpha, infinity)
+		if score > bestScore {
+			bestScore = score
+			bestRow, bestCol = cell.Row, cell.Col
+		}
+		alpha = max(alpha, score)
+	}
+
+	return bestRow, bestCol, nil
+}
+
+// minimax returns the value of g from the maximizer's point of view
+// alpha and beta bound the window of scores that can still affect the result
+func minimax(g game.Game, maximizer game.Player, depth, alpha, beta int) int {
+	if g.State != game.InProgress {
+		return terminalScore(g.State, maximizer, depth)
+	}
+
+	maximizing := g.CurrentPlayer == maximizer
+	best := infinity
+	if maximizing {
+		best = -infinity
+	}
+
+	for _, cell := range emptyCells(g.Board) {
+		next, err := g.MakeMove(cell.Row, cell.Col)
+		if err != nil {
+			continue
+		}
+		score := minimax(next, maximizer, depth+1, alpha, beta)
+		if maximizing {
+			best = max(best, score)
+			alpha = max(alpha, score)
+		} else {
+			best = min(best, score)
+			beta = min(beta, score)
+		}
+		if alpha >= beta {
+			break
+		}
+	}
+
+	return best
+}
+
+// terminalScore scores a finished game from the maximizer's point of view
+func terminalScore(state game.GameState, maximizer game.Player, depth int) int {
+	switch state {
+	case game.Player1Won:
+		return signedWin(maximizer == game.Player1, depth)
+	case game.Player2Won:
+		return signedWin(maximizer == game.Player2, depth)
+	default:
+		return drawScore
+	}
+}
+
+// signedWin returns a positive score for a win and a negative score for a loss
+func signedWin(won bool, depth int) int {
+	if won {
+		return winScore - depth
+	}
+	return depth - winScore
+}
+
+// emptyCells returns every empty position on the board in row-major order
+func emptyCells(board game.Board) []game.Position {
+	cells := make([]game.Position, 0, board.Width()*board.Height())
+	for row := 0; row < board.Height(); row++ {
+		for col := 0; col < board.Width(); col++ {
+			if board.IsCellEmpty(row, col) {
+				cells = append(cells, game.Position{Row: row, Col: col})
+			}
+		}
+	}
+	return cells
+}
@@ -0,0 +1,130 @@
+package ai
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// playMoves applies a sequence of moves to a new game
+func playMoves(t *testing.T, moves [][2]int) game.Game {
+	t.Helper()
+	g := game.NewGame()
+	for i, move := range moves {
+		var err error
+		g, err = g.MakeMove(move[0], move[1])
+		if err != nil {
+			t.Fatalf("Move %d at (%d,%d) failed: %v", i+1, move[0], move[1], err)
+		}
+	}
+	return g
+}
+
+// TestBestMoveTakesWin verifies the engine completes an open line
+func TestBestMoveTakesWin(t *testing.T) {
+	// X: (0,0) (0,1)  O: (1,0) (1,1) - X to move wins at (0,2)
+	g := playMoves(t, [][2]int{{0, 0}, {1, 0}, {0, 1}, {1, 1}})
+
+	row, col, err := BestMove(g)
+	if err != nil {
+		t.Fatalf("BestMove() returned error: %v", err)
+	}
+	if row != 0 || col != 2 {
+		t.Errorf("BestMove() = (%d, %d), want (0, 2)", row, col)
+	}
+}
+
+// TestBestMoveBlocksLoss verifies the engine blocks the opponent's line
+func TestBestMoveBlocksLoss(t *testing.T) {
+	// X: (0,0) (0,1)  O: (1,1) - O to move must block at (0,2)
+	g := playMoves(t, [][2]int{{0, 0}, {1, 1}, {0, 1}})
+
+	row, col, err := BestMove(g)
+	if err != nil {
+		t.Fatalf("BestMove() returned error: %v", err)
+	}
+	if row != 0 || col != 2 {
+		t.Errorf("BestMove() = (%d, %d), want (0, 2)", row, col)
+	}
+}
+
+// TestBestMoveGameOver verifies an error is returned for finished games
+func TestBestMoveGameOver(t *testing.T) {
+	g := playMoves(t, [][2]int{{0, 0}, {1, 0}, {0, 1}, {1, 1}, {0, 2}})
+
+	_, _, err := BestMove(g)
+	if !errors.Is(err, ErrGameOver) {
+		t.Errorf("BestMove() error = %v, want ErrGameOver", err)
+	}
+}
+
+// TestSelfPlayIsDraw verifies two perfect players always draw
+func TestSelfPlayIsDraw(t *testing.T) {
+	g := game.NewGame()
+	for g.State == game.InProgress {
+		row, col, err := BestMove(g)
+		if err != nil {
+			t.Fatalf("BestMove() returned error: %v", err)
+		}
+		g, err = g.MakeMove(row, col)
+		if err != nil {
+			t.Fatalf("MakeMove(%d, %d) returned error: %v", row, col, err)
+		}
+	}
+
+	if g.State != game.Draw {
+		t.Errorf("Self-play state = %v, want Draw", g.State)
+	}
+}
+
+// TestNeverLoses verifies the engine never loses against any sequence of opponent moves
+func TestNeverLoses(t *testing.T) {
+	tests := []struct {
+		name     string
+		computer game.Player
+	}{
+		{"Engine as Player 1", game.Player1},
+		{"Engine as Player 2", game.Player2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertNeverLoses(t, game.NewGame(), tt.computer)
+		})
+	}
+}
+
+// assertNeverLoses explores every opponent reply and fails if the engine loses
+func assertNeverLoses(t *testing.T, g game.Game, computer game.Player) {
+	t.Helper()
+	if g.State != game.InProgress {
+		lost := (g.State == game.Player1Won && computer == game.Player2) ||
+			(g.State == game.Player2Won && computer == game.Player1)
+		if lost {
+			t.Fatalf("Engine lost with board %v", g.Board)
+		}
+		return
+	}
+
+	if g.CurrentPlayer == computer {
+		row, col, err := BestMove(g)
+		if err != nil {
+			t.Fatalf("BestMove() returned error: %v", err)
+		}
+		next, err := g.MakeMove(row, col)
+		if err != nil {
+			t.Fatalf("MakeMove(%d, %d) returned error: %v", row, col, err)
+		}
+		assertNeverLoses(t, next, computer)
+		return
+	}
+
+	for _, cell := range emptyCells(g.Board) {
+		next, err := g.MakeMove(cell.Row, cell.Col)
+		if err != nil {
+			t.Fatalf("MakeMove(%d, %d) returned error: %v", cell.Row, cell.Col, err)
+		}
+		assertNeverLoses(t, next, computer)
+	}
+}
@@ -0,0 +1,240 @@
+package ai
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// Strategy chooses a move for a player on a given board
+// Implementations must only return empty cells within the board
+type Strategy interface {
+	ChooseMove(board game.Board, player game.Player) (row, col int, err error)
+}
+
+// Difficulty selects one of the built-in strategies
+type Difficulty int
+
+const (
+	// Random plays uniformly at random over the empty cells
+	Random Difficulty = iota
+	// Greedy wins if it can, blocks if it must and otherwise plays randomly;
+	// under misere rules it plays randomly but never completes its own line
+	// while it has a choice
+	Greedy
+	// Heuristic searches a few plies ahead and scores open lines
+	Heuristic
+	// Perfect searches the full game tree and never loses
+	Perfect
+)
+
+// ErrUnknownDifficulty indicates a difficulty name that is not recognised
+var ErrUnknownDifficulty = errors.New("unknown difficulty: use random, greedy, heuristic or perfect")
+
+// ErrInvalidEpsilon indicates a blunder rate outside [0, 1]
+var ErrInvalidEpsilon = errors.New("epsilon must be between 0 and 1")
+
+// String returns the flag name of the difficulty
+func (d Difficulty) String() string {
+	switch d {
+	case Random:
+		return "random"
+	case Greedy:
+		return "greedy"
+	case Heuristic:
+		return "heuristic"
+	case Perfect:
+		return "perfect"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseDifficulty converts a difficulty name into a Difficulty
+func ParseDifficulty(name string) (Difficulty, error) {
+	for _, d := range []Difficulty{Random, Greedy, Heuristic, Perfect} {
+		if strings.EqualFold(strings.TrimSpace(name), d.String()) {
+			return d, nil
+		}
+	}
+	return Random, fmt.Errorf("%w: %q", ErrUnknownDifficulty, name)
+}
+
+// MaxPerfectCells is the largest number of empty cells PerfectStrategy searches exhaustively
+// Beyond it the full tree is too large to search interactively, so PerfectStrategy
+// plays like HeuristicStrategy until the board has filled up
+const MaxPerfectCells = 10
+
+// New returns the strategy for the given difficulty on boards described by config
+// rng drives every random choice so games can be reproduced from a seed
+func New(d Difficulty, config game.Config, rng *rand.Rand) Strategy {
+	switch d {
+	case Greedy:
+		return NewGreedy(config, rng)
+	case Heuristic:
+		return NewHeuristic(config, DefaultHeuristicDepth)
+	case Perfect:
+		return NewPerfect(config)
+	default:
+		return NewRandom(config, rng)
+	}
+}
+
+// WithEpsilon wraps a strategy so that it plays a random move with probability epsilon
+// An epsilon of 0 returns the strategy unchanged
+func WithEpsilon(s Strategy, epsilon float64, rng *rand.Rand) (Strategy, error) {
+	if epsilon < 0 || epsilon > 1 {
+		return nil, fmt.Errorf("%w: got %g", ErrInvalidEpsilon, epsilon)
+	}
+	if epsilon == 0 {
+		return s, nil
+	}
+	return EpsilonStrategy{Strategy: s, Epsilon: epsilon, rng: rng}, nil
+}
+
+// EpsilonStrategy mixes random blunders into another strategy
+type EpsilonStrategy struct {
+	Strategy Strategy // Strategy used when not blundering
+	Epsilon  float64  // Probability of playing a random move
+	rng      *rand.Rand
+}
+
+// ChooseMove plays a random move with probability Epsilon, otherwise defers to Strategy
+func (s EpsilonStrategy) ChooseMove(board game.Board, player game.Player) (int, int, error) {
+	if s.rng.Float64() < s.Epsilon {
+		if row, col, ok := randomCell(board, s.rng); ok {
+			return row, col, nil
+		}
+	}
+	return s.Strategy.ChooseMove(board, player)
+}
+
+// RandomStrategy plays uniformly at random over the empty cells
+type RandomStrategy struct {
+	Config game.Config // Board dimensions and win length
+	rng    *rand.Rand
+}
+
+// NewRandom returns a random strategy driven by rng
+func NewRandom(config game.Config, rng *rand.Rand) RandomStrategy {
+	return RandomStrategy{Config: config, rng: rng}
+}
+
+// ChooseMove returns a uniformly random empty cell
+func (s RandomStrategy) ChooseMove(board game.Board, _ game.Player) (int, int, error) {
+	if isFinished(s.Config, board) {
+		return 0, 0, ErrGameOver
+	}
+	row, col, _ := randomCell(board, s.rng)
+	return row, col, nil
+}
+
+// randomCell returns a uniformly random empty cell, or false if the board is full
+func randomCell(board game.Board, rng *rand.Rand) (int, int, bool) {
+	cells := emptyCells(board)
+	if len(cells) == 0 {
+		return 0, 0, false
+	}
+	cell := cells[rng.IntN(len(cells))]
+	return cell.Row, cell.Col, true
+}
+
+// GreedyStrategy looks one ply ahead: win if possible, block if needed
+type GreedyStrategy struct {
+	Config game.Config // Board dimensions and win length
+	rng    *rand.Rand
+}
+
+// NewGreedy returns a greedy strategy that falls back to random moves driven by rng
+func NewGreedy(config game.Config, rng *rand.Rand) GreedyStrategy {
+	return GreedyStrategy{Config: config, rng: rng}
+}
+
+// ChooseMove completes the player's line, otherwise blocks the opponent's,
+// otherwise plays a random empty cell
+// Under misere rules completing a line loses, so it plays a random cell that
+// does not complete the player's line, if there is one
+func (s GreedyStrategy) ChooseMove(board game.Board, player game.Player) (int, int, error) {
+	if isFinished(s.Config, board) {
+		return 0, 0, ErrGameOver
+	}
+	if s.Config.Variant == game.Misere {
+		return s.chooseSafeMove(board, player.GetMark())
+	}
+	if cell, ok := findCompletingMove(s.Config, board, player.GetMark()); ok {
+		return cell.Row, cell.Col, nil
+	}
+	if cell, ok := findCompletingMove(s.Config, board, player.Other().GetMark()); ok {
+		return cell.Row, cell.Col, nil
+	}
+	row, col, _ := randomCell(board, s.rng)
+	return row, col, nil
+}
+
+// chooseSafeMove returns a random empty cell that does not complete a line
+// for mark, or any random empty cell if every move does
+func (s GreedyStrategy) chooseSafeMove(board game.Board, mark game.Cell) (int, int, error) {
+	var safe []game.Position
+	for _, cell := range emptyCells(board) {
+		if !s.Config.CheckWin(board.SetCell(cell.Row, cell.Col, mark), mark) {
+			safe = append(safe, cell)
+		}
+	}
+	if len(safe) == 0 {
+		row, col, _ := randomCell(board, s.rng)
+		return row, col, nil
+	}
+	cell := safe[s.rng.IntN(len(safe))]
+	return cell.Row, cell.Col, nil
+}
+
+// findCompletingMove returns the first empty cell that wins the game for mark
+func findCompletingMove(config game.Config, board game.Board, mark game.Cell) (game.Position, bool) {
+	for _, cell := range emptyCells(board) {
+		if config.CheckWin(board.SetCell(cell.Row, cell.Col, mark), mark) {
+			return cell, true
+		}
+	}
+	return game.Position{}, false
+}
+
+// PerfectStrategy searches the full game tree with BestMove
+type PerfectStrategy struct {
+	Config game.Config // Board dimensions and win length
+}
+
+// NewPerfect returns a perfect-play strategy
+func NewPerfect(config game.Config) PerfectStrategy {
+	return PerfectStrategy{Config: config}
+}
+
+// ChooseMove returns the minimax-optimal move for player
+// With more than MaxPerfectCells empty cells it plays the heuristic move instead
+func (s PerfectStrategy) ChooseMove(board game.Board, player game.Player) (int, int, error) {
+	if isFinished(s.Config, board) {
+		return 0, 0, ErrGameOver
+	}
+	if len(emptyCells(board)) > MaxPerfectCells {
+		return NewHeuristic(s.Config, DefaultHeuristicDepth).ChooseMove(board, player)
+	}
+	return BestMove(gameFor(s.Config, board, player))
+}
+
+// gameFor builds an in-progress game with player to move on board
+func gameFor(config game.Config, board game.Board, player game.Player) game.Game {
+	return game.Game{
+		Config:        config,
+		Board:         board,
+		CurrentPlayer: player,
+		State:         game.InProgress,
+		MoveCount:     board.CountOccupied(),
+	}
+}
+
+// isFinished reports whether no further moves can be played on board
+func isFinished(config game.Config, board game.Board) bool {
+	return board.IsFull() || config.CheckWin(board, game.X) || config.CheckWin(board, game.O)
+}
@@ -0,0 +1,236 @@
+package ai
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// newTestRand returns a deterministic random source for tests
+func newTestRand() *rand.Rand {
+	return rand.New(rand.NewPCG(1, 2))
+}
+
+// TestParseDifficulty verifies difficulty names are parsed case-insensitively
+func TestParseDifficulty(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		want      Difficulty
+		wantError bool
+	}{
+		{"Random", "random", Random, false},
+		{"Greedy", "greedy", Greedy, false},
+		{"Heuristic uppercase", "HEURISTIC", Heuristic, false},
+		{"Perfect with spaces", " perfect ", Perfect, false},
+		{"Unknown name", "impossible", Random, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDifficulty(tt.input)
+			if tt.wantError {
+				if !errors.Is(err, ErrUnknownDifficulty) {
+					t.Errorf("ParseDifficulty(%q) error = %v, want ErrUnknownDifficulty", tt.input, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDifficulty(%q) unexpected error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseDifficulty(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestStrategiesReturnEmptyCells verifies every strategy picks a legal move
+func TestStrategiesReturnEmptyCells(t *testing.T) {
+	board := game.Board{
+		{game.X, game.O, game.X},
+		{game.O, game.Empty, game.Empty},
+		{game.Empty, game.Empty, game.Empty},
+	}
+
+	for _, d := range []Difficulty{Random, Greedy, Heuristic, Perfect} {
+		t.Run(d.String(), func(t *testing.T) {
+			row, col, err := New(d, game.StandardConfig(), newTestRand()).ChooseMove(board, game.Player1)
+			if err != nil {
+				t.Fatalf("ChooseMove() returned error: %v", err)
+			}
+			if !board.IsCellEmpty(row, col) {
+				t.Errorf("ChooseMove() = (%d, %d), want an empty cell", row, col)
+			}
+		})
+	}
+}
+
+// TestStrategiesRejectFinishedBoard verifies ErrGameOver on won or full boards
+func TestStrategiesRejectFinishedBoard(t *testing.T) {
+	won := game.Board{
+		{game.X, game.X, game.X},
+		{game.O, game.O, game.Empty},
+		{game.Empty, game.Empty, game.Empty},
+	}
+
+	for _, d := range []Difficulty{Random, Greedy, Heuristic, Perfect} {
+		t.Run(d.String(), func(t *testing.T) {
+			_, _, err := New(d, game.StandardConfig(), newTestRand()).ChooseMove(won, game.Player2)
+			if !errors.Is(err, ErrGameOver) {
+				t.Errorf("ChooseMove() error = %v, want ErrGameOver", err)
+			}
+		})
+	}
+}
+
+// TestTacticalStrategiesWinAndBlock verifies greedy and stronger strategies take wins and blocks
+func TestTacticalStrategiesWinAndBlock(t *testing.T) {
+	tests := []struct {
+		name    string
+		board   game.Board
+		player  game.Player
+		wantRow int
+		wantCol int
+	}{
+		{
+			name: "Win beats block",
+			board: game.Board{
+				{game.X, game.X, game.Empty},
+				{game.O, game.O, game.Empty},
+				{game.Empty, game.Empty, game.Empty},
+			},
+			player:  game.Player1,
+			wantRow: 0,
+			wantCol: 2,
+		},
+		{
+			name: "Block opponent's column",
+			board: game.Board{
+				{game.X, game.O, game.Empty},
+				{game.X, game.Empty, game.Empty},
+				{game.Empty, game.Empty, game.Empty},
+			},
+			player:  game.Player2,
+			wantRow: 2,
+			wantCol: 0,
+		},
+	}
+
+	for _, d := range []Difficulty{Greedy, Heuristic, Perfect} {
+		for _, tt := range tests {
+			t.Run(d.String()+"/"+tt.name, func(t *testing.T) {
+				row, col, err := New(d, game.StandardConfig(), newTestRand()).ChooseMove(tt.board, tt.player)
+				if err != nil {
+					t.Fatalf("ChooseMove() returned error: %v", err)
+				}
+				if row != tt.wantRow || col != tt.wantCol {
+					t.Errorf("ChooseMove() = (%d, %d), want (%d, %d)", row, col, tt.wantRow, tt.wantCol)
+				}
+			})
+		}
+	}
+}
+
+// TestStrategiesAvoidLinesInMisere verifies searching strategies never complete their own line under misere rules
+func TestStrategiesAvoidLinesInMisere(t *testing.T) {
+	config := game.Config{Width: 3, Height: 3, WinLength: 3, Variant: game.Misere}
+	board := game.Board{
+		{game.X, game.X, game.Empty},
+		{game.O, game.O, game.Empty},
+		{game.Empty, game.Empty, game.Empty},
+	}
+
+	for _, d := range []Difficulty{Greedy, Heuristic, Perfect} {
+		t.Run(d.String(), func(t *testing.T) {
+			s := New(d, config, newTestRand())
+			for range 20 {
+				row, col, err := s.ChooseMove(board, game.Player1)
+				if err != nil {
+					t.Fatalf("ChooseMove() returned error: %v", err)
+				}
+				if row == 0 && col == 2 {
+					t.Fatal("ChooseMove() completed X's top row")
+				}
+			}
+		})
+	}
+}
+
+// TestWithEpsilon verifies blunder rates are validated and applied
+func TestWithEpsilon(t *testing.T) {
+	perfect := New(Perfect, game.StandardConfig(), newTestRand())
+
+	if _, err := WithEpsilon(perfect, 1.5, newTestRand()); !errors.Is(err, ErrInvalidEpsilon) {
+		t.Errorf("WithEpsilon(1.5) error = %v, want ErrInvalidEpsilon", err)
+	}
+
+	same, err := WithEpsilon(perfect, 0, newTestRand())
+	if err != nil {
+		t.Fatalf("WithEpsilon(0) unexpected error: %v", err)
+	}
+	if _, ok := same.(PerfectStrategy); !ok {
+		t.Errorf("WithEpsilon(0) = %T, want PerfectStrategy", same)
+	}
+
+	// With epsilon 1 every move comes from the random strategy
+	board := game.NewBoard()
+	always, err := WithEpsilon(perfect, 1, newTestRand())
+	if err != nil {
+		t.Fatalf("WithEpsilon(1) unexpected error: %v", err)
+	}
+	rng := newTestRand()
+	rng.Float64() // consume the draw made by the epsilon check
+	wantRow, wantCol, _ := NewRandom(game.StandardConfig(), rng).ChooseMove(board, game.Player1)
+	row, col, err := always.ChooseMove(board, game.Player1)
+	if err != nil {
+		t.Fatalf("ChooseMove() returned error: %v", err)
+	}
+	if row != wantRow || col != wantCol {
+		t.Errorf("ChooseMove() = (%d, %d), want random move (%d, %d)", row, col, wantRow, wantCol)
+	}
+}
+
+// TestRandomStrategyIsReproducible verifies the same seed yields the same moves
+func TestRandomStrategyIsReproducible(t *testing.T) {
+	board := game.NewBoard()
+	first, second := NewRandom(game.StandardConfig(), newTestRand()), NewRandom(game.StandardConfig(), newTestRand())
+
+	for i := 0; i < 5; i++ {
+		r1, c1, _ := first.ChooseMove(board, game.Player1)
+		r2, c2, _ := second.ChooseMove(board, game.Player1)
+		if r1 != r2 || c1 != c2 {
+			t.Fatalf("Move %d differs: (%d, %d) vs (%d, %d)", i, r1, c1, r2, c2)
+		}
+	}
+}
+
+// TestPerfectOnLargerBoard verifies perfect play stays responsive and legal beyond 3x3
+func TestPerfectOnLargerBoard(t *testing.T) {
+	config := game.Config{Width: 4, Height: 4, WinLength: 4}
+	strategy := NewPerfect(config)
+
+	// Too many empty cells for a full search: falls back to the heuristic
+	board := game.NewBoardSize(4, 4)
+	row, col, err := strategy.ChooseMove(board, game.Player1)
+	if err != nil || !board.IsCellEmpty(row, col) {
+		t.Fatalf("ChooseMove(empty 4x4) = (%d, %d, %v), want an empty cell", row, col, err)
+	}
+
+	// Few enough empty cells: full search finds the only winning move
+	board = game.Board{
+		{game.X, game.X, game.X, game.Empty},
+		{game.O, game.O, game.O, game.Empty},
+		{game.X, game.O, game.Empty, game.Empty},
+		{game.Empty, game.Empty, game.Empty, game.Empty},
+	}
+	row, col, err = strategy.ChooseMove(board, game.Player1)
+	if err != nil {
+		t.Fatalf("ChooseMove() returned error: %v", err)
+	}
+	if row != 0 || col != 3 {
+		t.Errorf("ChooseMove() = (%d, %d), want (0, 3)", row, col)
+	}
+}
@@ -0,0 +1,250 @@
+// Package bitboard provides a compact encoding of game boards for search
+//
+// A Bitboard holds one bitmask per player, with bit row*width+col set when
+// that player has a mark in the cell. A Layout precomputes the masks of every
+// winning line and the Zobrist keys for one board configuration, so win
+// checks are a few mask comparisons and positions can be hashed
+// incrementally while searching
+package bitboard
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"math/rand/v2"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// MaxCells is the largest number of cells a Bitboard can hold
+const MaxCells = 64
+
+// zobristSeed seeds the Zobrist keys so hashes are stable across runs
+const zobristSeed = 0x7469637461630000
+
+// Errors returned by NewLayout and Layout.FromBoard
+var (
+	// ErrTooManyCells indicates a board with more than MaxCells cells
+	ErrTooManyCells = errors.New("board has too many cells for a bitboard")
+
+	// ErrBoardSize indicates a board whose dimensions do not match the layout
+	ErrBoardSize = errors.New("board dimensions do not match the bitboard layout")
+)
+
+// Bitboard is a board encoded as one bitmask per player
+// The zero value is the empty board of any Layout
+type Bitboard struct {
+	X uint64 // Cells holding X
+	O uint64 // Cells holding O
+}
+
+// Occupied returns the mask of cells holding either mark
+func (b Bitboard) Occupied() uint64 {
+	return b.X | b.O
+}
+
+// Mask returns the mask of cells holding mark, or 0 for Empty
+func (b Bitboard) Mask(mark game.Cell) uint64 {
+	switch mark {
+	case game.X:
+		return b.X
+	case game.O:
+		return b.O
+	default:
+		return 0
+	}
+}
+
+// Cell returns the mark in cell i
+func (b Bitboard) Cell(i int) game.Cell {
+	bit := uint64(1) << i
+	switch {
+	case b.X&bit != 0:
+		return game.X
+	case b.O&bit != 0:
+		return game.O
+	default:
+		return game.Empty
+	}
+}
+
+// Set returns a copy of b with cell i holding mark, replacing any earlier mark
+func (b Bitboard) Set(i int, mark game.Cell) Bitboard {
+	bit := uint64(1) << i
+	b.X &^= bit
+	b.O &^= bit
+	switch mark {
+	case game.X:
+		b.X |= bit
+	case game.O:
+		b.O |= bit
+	}
+	return b
+}
+
+// Layout holds the winning-line masks and Zobrist keys for one configuration
+// A Layout is immutable and safe for concurrent use
+type Layout struct {
+	config       game.Config
+	full         uint64     // Every cell on the board
+	lines        []uint64   // One mask per winning line
+	linesThrough [][]uint64 // linesThrough[i] holds the lines containing cell i
+	keys         [2][]uint64
+	sideKey      uint64 // Toggled when O is to move
+}
+
+// NewLayout precomputes the masks and keys for boards described by config
+// Returns ErrTooManyCells for boards larger than MaxCells
+func NewLayout(config game.Config) (*Layout, error) {
+	if config == (game.Config{}) {
+		config = game.StandardConfig()
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	cells := config.Width * config.Height
+	if cells > MaxCells {
+		return nil, fmt.Errorf("%w: %s has %d cells, at most %d", ErrTooManyCells, config, cells, MaxCells)
+	}
+
+	l := &Layout{config: config, linesThrough: make([][]uint64, cells)}
+	l.full = ^uint64(0) >> (MaxCells - cells)
+	for _, line := range config.Lines() {
+		var mask uint64
+		for _, p := range line {
+			mask |= 1 << l.Index(p.Row, p.Col)
+		}
+		l.lines = append(l.lines, mask)
+		for _, p := range line {
+			i := l.Index(p.Row, p.Col)
+			l.linesThrough[i] = append(l.linesThrough[i], mask)
+		}
+	}
+
+	rng := rand.New(rand.NewPCG(zobristSeed, uint64(cells)))
+	for side := range l.keys {
+		l.keys[side] = make([]uint64, cells)
+		for i := range l.keys[side] {
+			l.keys[side][i] = rng.Uint64()
+		}
+	}
+	l.sideKey = rng.Uint64()
+	return l, nil
+}
+
+// Config returns the configuration the layout was built for
+func (l *Layout) Config() game.Config {
+	return l.config
+}
+
+// Cells returns the number of cells on the board
+func (l *Layout) Cells() int {
+	return l.config.Width * l.config.Height
+}
+
+// Index returns the bit of the cell at row and col
+func (l *Layout) Index(row, col int) int {
+	return row*l.config.Width + col
+}
+
+// Position returns the row and column of bit i
+func (l *Layout) Position(i int) game.Position {
+	return game.Position{Row: i / l.config.Width, Col: i % l.config.Width}
+}
+
+// Empty returns the mask of empty cells on b
+func (l *Layout) Empty(b Bitboard) uint64 {
+	return l.full &^ b.Occupied()
+}
+
+// IsFull returns true if every cell on b holds a mark
+func (l *Layout) IsFull(b Bitboard) bool {
+	return b.Occupied() == l.full
+}
+
+// CheckWin determines if mark has a complete winning line on b
+func (l *Layout) CheckWin(b Bitboard, mark game.Cell) bool {
+	return hasLine(b.Mask(mark), l.lines)
+}
+
+// CheckWinThrough determines if mark has a complete winning line through cell i
+// After a move it only needs to check the lines through the cell just played
+func (l *Layout) CheckWinThrough(b Bitboard, mark game.Cell, i int) bool {
+	return hasLine(b.Mask(mark), l.linesThrough[i])
+}
+
+// CheckDraw determines if b is full with no winning line for either player
+func (l *Layout) CheckDraw(b Bitboard) bool {
+	return l.IsFull(b) && !l.CheckWin(b, game.X) && !l.CheckWin(b, game.O)
+}
+
+// hasLine reports whether mask covers any of lines
+func hasLine(mask uint64, lines []uint64) bool {
+	for _, line := range lines {
+		if mask&line == line {
+			return true
+		}
+	}
+	return false
+}
+
+// FromBoard encodes board, which must have the layout's dimensions
+func (l *Layout) FromBoard(board game.Board) (Bitboard, error) {
+	if board.Width() != l.config.Width || board.Height() != l.config.Height {
+		return Bitboard{}, fmt.Errorf("%w: board is %dx%d, layout is %dx%d",
+			ErrBoardSize, board.Width(), board.Height(), l.config.Width, l.config.Height)
+	}
+
+	var b Bitboard
+	for row := range board {
+		for col, cell := range board[row] {
+			b = b.Set(l.Index(row, col), cell)
+		}
+	}
+	return b, nil
+}
+
+// Board decodes b into a new game.Board
+func (l *Layout) Board(b Bitboard) game.Board {
+	board := game.NewBoardSize(l.config.Width, l.config.Height)
+	for i := range l.Cells() {
+		p := l.Position(i)
+		board[p.Row][p.Col] = b.Cell(i)
+	}
+	return board
+}
+
+// Key returns the Zobrist key of mark in cell i, or 0 for Empty
+// XOR it into a hash to add or remove that mark
+func (l *Layout) Key(i int, mark game.Cell) uint64 {
+	switch mark {
+	case game.X:
+		return l.keys[0][i]
+	case game.O:
+		return l.keys[1][i]
+	default:
+		return 0
+	}
+}
+
+// SideKey returns the Zobrist key XORed into a hash when O is to move
+func (l *Layout) SideKey() uint64 {
+	return l.sideKey
+}
+
+// Hash returns the Zobrist hash of b with next to move
+// Equal positions always hash equally; after a move the hash can be updated
+// incrementally by XORing Key for the new mark and SideKey
+func (l *Layout) Hash(b Bitboard, next game.Player) uint64 {
+	var h uint64
+	for x := b.X; x != 0; x &= x - 1 {
+		h ^= l.keys[0][bits.TrailingZeros64(x)]
+	}
+	for o := b.O; o != 0; o &= o - 1 {
+		h ^= l.keys[1][bits.TrailingZeros64(o)]
+	}
+	if next == game.Player2 {
+		h ^= l.sideKey
+	}
+	return h
+}
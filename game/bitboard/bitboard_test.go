@@ -0,0 +1,234 @@
+package bitboard
+
+import (
+	"errors"
+	"math/bits"
+	"testing"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// completeGames is the number of distinct move sequences in classic tic-tac-toe
+const completeGames = 255168
+
+// mustLayout builds a layout or fails the test
+func mustLayout(tb testing.TB, config game.Config) *Layout {
+	tb.Helper()
+	l, err := NewLayout(config)
+	if err != nil {
+		tb.Fatalf("NewLayout(%v) returned error: %v", config, err)
+	}
+	return l
+}
+
+// TestNewLayout verifies line counts and size limits
+func TestNewLayout(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    game.Config
+		wantLines int
+		wantErr   error
+	}{
+		{"Classic", game.StandardConfig(), 8, nil},
+		{"Zero value", game.Config{}, 8, nil},
+		{"4x4 four in a row", game.Config{Width: 4, Height: 4, WinLength: 4}, 10, nil},
+		{"8x8 is the largest", game.Config{Width: 8, Height: 8, WinLength: 5}, 96, nil},
+		{"Too many cells", game.Config{Width: 9, Height: 8, WinLength: 5}, 0, ErrTooManyCells},
+		{"Invalid config", game.Config{Width: 3, Height: 3, WinLength: 4}, 0, game.ErrInvalidConfig},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := NewLayout(tt.config)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewLayout() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && len(l.lines) != tt.wantLines {
+				t.Errorf("NewLayout() has %d lines, want %d", len(l.lines), tt.wantLines)
+			}
+		})
+	}
+}
+
+// TestBoardConversion verifies boards survive a round trip through a bitboard
+func TestBoardConversion(t *testing.T) {
+	tests := []string{
+		".../.../...",
+		"X.O/.X./..O",
+		"XOX/XOO/OXX",
+		"X.../.O../..X./...O/....",
+	}
+
+	for _, s := range tests {
+		t.Run(s, func(t *testing.T) {
+			board, err := game.ParseBoard(s)
+			if err != nil {
+				t.Fatalf("ParseBoard() returned error: %v", err)
+			}
+			l := mustLayout(t, game.Config{Width: board.Width(), Height: board.Height(), WinLength: 3})
+			b, err := l.FromBoard(board)
+			if err != nil {
+				t.Fatalf("FromBoard() returned error: %v", err)
+			}
+			if got := l.Board(b); !got.Equal(board) {
+				t.Errorf("Board(FromBoard(%q)) = %q", s, got)
+			}
+			if bits.OnesCount64(b.Occupied()) != board.CountOccupied() {
+				t.Errorf("Occupied() has %d bits, want %d", bits.OnesCount64(b.Occupied()), board.CountOccupied())
+			}
+		})
+	}
+
+	if _, err := mustLayout(t, game.StandardConfig()).FromBoard(game.NewBoardSize(4, 3)); !errors.Is(err, ErrBoardSize) {
+		t.Errorf("FromBoard(4x3) error = %v, want ErrBoardSize", err)
+	}
+}
+
+// TestCheckWinMatchesBoard verifies mask win checks agree with game.Config on
+// every position of classic tic-tac-toe
+func TestCheckWinMatchesBoard(t *testing.T) {
+	config := game.StandardConfig()
+	l := mustLayout(t, config)
+
+	// Every assignment of Empty, X or O to the nine cells
+	for n := 0; n < 19683; n++ {
+		var b Bitboard
+		for i, v := 0, n; i < 9; i, v = i+1, v/3 {
+			b = b.Set(i, game.Cell(v%3))
+		}
+		board := l.Board(b)
+		for _, mark := range []game.Cell{game.X, game.O} {
+			if got, want := l.CheckWin(b, mark), config.CheckWin(board, mark); got != want {
+				t.Fatalf("CheckWin(%q, %v) = %v, want %v", board, mark, got, want)
+			}
+		}
+		if got, want := l.CheckDraw(b), config.CheckDraw(board); got != want {
+			t.Fatalf("CheckDraw(%q) = %v, want %v", board, got, want)
+		}
+	}
+}
+
+// TestCheckWinThrough verifies only lines through the given cell are checked
+func TestCheckWinThrough(t *testing.T) {
+	l := mustLayout(t, game.StandardConfig())
+	b := Bitboard{}.Set(0, game.X).Set(1, game.X).Set(2, game.X)
+
+	if !l.CheckWinThrough(b, game.X, 1) {
+		t.Error("CheckWinThrough(top row, cell 1) = false, want true")
+	}
+	if l.CheckWinThrough(b, game.X, 4) {
+		t.Error("CheckWinThrough(top row, center) = true, want false")
+	}
+}
+
+// TestHash verifies Zobrist hashes are stable and can be updated incrementally
+func TestHash(t *testing.T) {
+	l := mustLayout(t, game.StandardConfig())
+	empty := l.Hash(Bitboard{}, game.Player1)
+	if empty != 0 {
+		t.Errorf("Hash(empty, X to move) = %#x, want 0", empty)
+	}
+
+	b := Bitboard{}.Set(4, game.X)
+	h := l.Hash(b, game.Player2)
+	if incremental := empty ^ l.Key(4, game.X) ^ l.SideKey(); incremental != h {
+		t.Errorf("Incremental hash = %#x, want %#x", incremental, h)
+	}
+	if again := mustLayout(t, game.StandardConfig()).Hash(b, game.Player2); again != h {
+		t.Errorf("Hash() from a second layout = %#x, want %#x", again, h)
+	}
+
+	// Distinct positions of classic tic-tac-toe should not collide
+	seen := map[uint64]Bitboard{}
+	var walk func(b Bitboard, next game.Player)
+	walk = func(b Bitboard, next game.Player) {
+		h := l.Hash(b, next)
+		if other, ok := seen[h]; ok && other != b {
+			t.Fatalf("Hash collision between %q and %q", l.Board(b), l.Board(other))
+		}
+		seen[h] = b
+		for empty := l.Empty(b); empty != 0; empty &= empty - 1 {
+			walk(b.Set(bits.TrailingZeros64(empty), next.GetMark()), next.Other())
+		}
+	}
+	walk(Bitboard{}, game.Player1)
+	if len(seen) != 6046 {
+		t.Errorf("Hashed %d positions, want the 6046 reachable without stopping at wins", len(seen))
+	}
+}
+
+// countGames counts complete games below g by playing every move with game.Game
+func countGames(g game.Game) int {
+	if g.State != game.InProgress {
+		return 1
+	}
+	count := 0
+	for row := range g.Board.Height() {
+		for col := range g.Board.Width() {
+			if next, err := g.MakeMove(row, col); err == nil {
+				count += countGames(next)
+			}
+		}
+	}
+	return count
+}
+
+// countBitboardGames counts complete games below b with player to move
+func countBitboardGames(l *Layout, b Bitboard, player game.Player) int {
+	mark := player.GetMark()
+	count := 0
+	for empty := l.Empty(b); empty != 0; empty &= empty - 1 {
+		i := bits.TrailingZeros64(empty)
+		next := b.Set(i, mark)
+		if l.CheckWinThrough(next, mark, i) || l.IsFull(next) {
+			count++
+			continue
+		}
+		count += countBitboardGames(l, next, player.Other())
+	}
+	return count
+}
+
+// TestEnumeration verifies both representations find every complete game
+func TestEnumeration(t *testing.T) {
+	if got := countGames(game.NewGame()); got != completeGames {
+		t.Errorf("countGames() = %d, want %d", got, completeGames)
+	}
+	if got := countBitboardGames(mustLayout(t, game.StandardConfig()), Bitboard{}, game.Player1); got != completeGames {
+		t.Errorf("countBitboardGames() = %d, want %d", got, completeGames)
+	}
+}
+
+// BenchmarkEnumerateBoard enumerates the full game tree with game.Game.MakeMove
+func BenchmarkEnumerateBoard(b *testing.B) {
+	for b.Loop() {
+		countGames(game.NewGame())
+	}
+}
+
+// BenchmarkEnumerateBitboard enumerates the full game tree with bitboards
+func BenchmarkEnumerateBitboard(b *testing.B) {
+	l := mustLayout(b, game.StandardConfig())
+	for b.Loop() {
+		countBitboardGames(l, Bitboard{}, game.Player1)
+	}
+}
+
+// BenchmarkCheckWinBoard checks a full board for wins with game.Config
+func BenchmarkCheckWinBoard(b *testing.B) {
+	config := game.StandardConfig()
+	board, _ := game.ParseBoard("XOX/XOO/OXX")
+	for b.Loop() {
+		config.CheckWin(board, game.X)
+	}
+}
+
+// BenchmarkCheckWinBitboard checks a full board for wins with line masks
+func BenchmarkCheckWinBitboard(b *testing.B) {
+	l := mustLayout(b, game.StandardConfig())
+	board, _ := game.ParseBoard("XOX/XOO/OXX")
+	bb, _ := l.FromBoard(board)
+	for b.Loop() {
+		l.CheckWin(bb, game.X)
+	}
+}
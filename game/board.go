@@ -32,42 +32,96 @@ func (c Cell) IsOccupied() bool {
 	return c != Empty
 }
 
-// BOARD_SIZE defines the dimensions of the tic-tac-toe board (3x3)
+// BOARD_SIZE defines the dimensions of the classic tic-tac-toe board (3x3)
 const BOARD_SIZE = 3
 
-// Board represents a 3x3 tic-tac-toe game board
-type Board [BOARD_SIZE][BOARD_SIZE]Cell
+// Board represents a tic-tac-toe game board as a slice of rows
+// The classic board is 3x3, but boards of any width and height are supported
+// Boards are treated as immutable values: SetCell always returns a copy
+type Board [][]Cell
 
-// NewBoard creates and returns a new empty board
+// NewBoard creates and returns a new empty 3x3 board
 func NewBoard() Board {
-	return Board{} // All cells initialized to Empty (zero value)
+	return NewBoardSize(BOARD_SIZE, BOARD_SIZE)
+}
+
+// NewBoardSize creates and returns a new empty board with the given dimensions
+func NewBoardSize(width, height int) Board {
+	cells := make([]Cell, width*height) // All cells initialized to Empty (zero value)
+	board := make(Board, height)
+	for row := range board {
+		board[row] = cells[row*width : (row+1)*width : (row+1)*width]
+	}
+	return board
+}
+
+// Width returns the number of columns on the board
+func (b Board) Width() int {
+	if len(b) == 0 {
+		return 0
+	}
+	return len(b[0])
+}
+
+// Height returns the number of rows on the board
+func (b Board) Height() int {
+	return len(b)
+}
+
+// InBounds returns true if row and col address a cell on the board
+func (b Board) InBounds(row, col int) bool {
+	return row >= 0 && row < b.Height() && col >= 0 && col < b.Width()
 }
 
 // GetCell returns the cell value at the specified position
-// row and col must be in range [0, 2]
+// row and col must be within the board (see InBounds)
 func (b Board) GetCell(row, col int) Cell {
 	return b[row][col]
 }
 
 // SetCell returns a new board with the cell at the specified position set to the given value
 // This function is immutable - it does not modify the original board
-// row and col must be in range [0, 2]
+// row and col must be within the board (see InBounds)
 func (b Board) SetCell(row, col int, cell Cell) Board {
-	newBoard := b
+	newBoard := b.Clone()
 	newBoard[row][col] = cell
 	return newBoard
 }
 
+// Clone returns a deep copy of the board
+func (b Board) Clone() Board {
+	newBoard := NewBoardSize(b.Width(), b.Height())
+	for row := range b {
+		copy(newBoard[row], b[row])
+	}
+	return newBoard
+}
+
+// Equal returns true if both boards have the same dimensions and cells
+func (b Board) Equal(other Board) bool {
+	if b.Width() != other.Width() || b.Height() != other.Height() {
+		return false
+	}
+	for row := range b {
+		for col := range b[row] {
+			if b[row][col] != other[row][col] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 // IsCellEmpty returns true if the cell at the specified position is empty
-// row and col must be in range [0, 2]
+// row and col must be within the board (see InBounds)
 func (b Board) IsCellEmpty(row, col int) bool {
 	return b[row][col] == Empty
 }
 
 // IsFull returns true if all cells on the board are occupied
 func (b Board) IsFull() bool {
-	for row := 0; row < BOARD_SIZE; row++ {
-		for col := 0; col < BOARD_SIZE; col++ {
+	for row := range b {
+		for col := range b[row] {
 			if b[row][col] == Empty {
 				return false
 			}
@@ -79,8 +133,8 @@ func (b Board) IsFull() bool {
 // CountOccupied returns the number of non-empty cells on the board
 func (b Board) CountOccupied() int {
 	count := 0
-	for row := 0; row < BOARD_SIZE; row++ {
-		for col := 0; col < BOARD_SIZE; col++ {
+	for row := range b {
+		for col := range b[row] {
 			if b[row][col] != Empty {
 				count++
 			}
@@ -103,17 +157,37 @@ const (
 	Draw
 )
 
+// String returns a human-readable description of the state
+func (s GameState) String() string {
+	switch s {
+	case InProgress:
+		return "In progress"
+	case Player1Won:
+		return "Player 1 (X) won"
+	case Player2Won:
+		return "Player 2 (O) won"
+	case Draw:
+		return "Draw"
+	default:
+		return "Unknown"
+	}
+}
+
 // Game represents the complete game context
 type Game struct {
+	Config        Config    // Board dimensions and win length
 	Board         Board     // Current board state
 	CurrentPlayer Player    // Whose turn it is
 	State         GameState // Current game status
-	MoveCount     int       // Number of moves made (0-9)
+	MoveCount     int       // Number of moves made (0 to Width*Height)
+	History       []Move    // Moves played so far, oldest first
+	Undone        []Move    // Moves taken back by Undo, most recent last
 }
 
-// NewGame creates and returns a new game instance
+// NewGame creates and returns a new classic 3x3 game instance
 func NewGame() Game {
 	return Game{
+		Config:        StandardConfig(),
 		Board:         NewBoard(),
 		CurrentPlayer: Player1,
 		State:         InProgress,
@@ -121,11 +195,41 @@ func NewGame() Game {
 	}
 }
 
-// MakeMove applies a move at the specified position and returns the new game state
+// NewGameWithConfig creates and returns a new game using the given board configuration
+// Returns ErrInvalidConfig if the configuration cannot be played
+func NewGameWithConfig(config Config) (Game, error) {
+	if err := config.Validate(); err != nil {
+		return Game{}, err
+	}
+	return Game{
+		Config:        config,
+		Board:         NewBoardSize(config.Width, config.Height),
+		CurrentPlayer: Player1,
+		State:         InProgress,
+		MoveCount:     0,
+	}, nil
+}
+
+// MakeMove places the current player's own mark at the specified position and returns the new game state
 // Returns an error if the move is invalid (out of bounds or cell occupied)
 func (g Game) MakeMove(row, col int) (Game, error) {
+	return g.MakeMoveMark(row, col, g.CurrentPlayer.GetMark())
+}
+
+// MakeMoveMark places mark at the specified position for the current player
+// and returns the new game state
+// Only the Wild variant lets a player place the opponent's mark. A completed
+// line is credited by Config.Outcome to the player who moved, not to the mark
+// that formed it
+// Returns ErrWrongMark for a mark the player may not place, and the errors of
+// MakeMove for an invalid position
+func (g Game) MakeMoveMark(row, col int, mark Cell) (Game, error) {
+	if !mark.IsOccupied() || (g.Config.Variant != Wild && mark != g.CurrentPlayer.GetMark()) {
+		return g, ErrWrongMark
+	}
+
 	// Validate position is within bounds
-	if row < 0 || row >= BOARD_SIZE || col < 0 || col >= BOARD_SIZE {
+	if !g.Board.InBounds(row, col) {
 		return g, ErrInvalidRange
 	}
 
@@ -138,37 +242,35 @@ func (g Game) MakeMove(row, col int) (Game, error) {
 	newGame := g
 
 	// Apply move to board
-	newGame.Board = g.Board.SetCell(row, col, g.CurrentPlayer.GetMark())
+	newGame.Board = g.Board.SetCell(row, col, mark)
 
 	// Increment move count
 	newGame.MoveCount++
 
-	// Check for win
-	if CheckWin(newGame.Board, g.CurrentPlayer.GetMark()) {
-		if g.CurrentPlayer == Player1 {
-			newGame.State = Player1Won
-		} else {
-			newGame.State = Player2Won
-		}
-		return newGame, nil
-	}
-
-	// Check for draw
-	if CheckDraw(newGame.Board) {
+	// Check for a completed line, then draw, otherwise switch player
+	switch {
+	case g.Config.CheckWin(newGame.Board, mark):
+		newGame.State = g.Config.Outcome(g.CurrentPlayer)
+	case g.Config.CheckDraw(newGame.Board):
 		newGame.State = Draw
-		return newGame, nil
+	default:
+		newGame.CurrentPlayer = g.CurrentPlayer.Other()
 	}
 
-	// Switch player
-	newGame.CurrentPlayer = g.CurrentPlayer.Other()
+	// Record the move; a new move discards any undone moves
+	// The full slice expression forces append to copy instead of sharing storage
+	move := Move{Player: g.CurrentPlayer, Row: row, Col: col, Mark: mark, State: newGame.State}
+	newGame.History = append(g.History[:len(g.History):len(g.History)], move)
+	newGame.Undone = nil
 
 	return newGame, nil
 }
 
 // Error types for move validation
 var (
-	ErrInvalidRange = &GameError{"Invalid position. Row and column must be between 0 and 2"}
+	ErrInvalidRange = &GameError{"Invalid position. Row and column must be on the board"}
 	ErrCellOccupied = &GameError{"Position already occupied. Please choose an empty cell"}
+	ErrWrongMark    = &GameError{"Wrong mark. Place your own mark; only the wild variant lets you place either X or O"}
 )
 
 // GameError represents a game-specific error
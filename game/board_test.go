@@ -23,6 +23,28 @@ func TestCellConstants(t *testing.T) {
 	}
 }
 
+// TestGameStateString verifies GameState String() method
+func TestGameStateString(t *testing.T) {
+	tests := []struct {
+		state    GameState
+		expected string
+	}{
+		{InProgress, "In progress"},
+		{Player1Won, "Player 1 (X) won"},
+		{Player2Won, "Player 2 (O) won"},
+		{Draw, "Draw"},
+		{GameState(99), "Unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			if got := tt.state.String(); got != tt.expected {
+				t.Errorf("GameState(%d).String() = %q, want %q", int(tt.state), got, tt.expected)
+			}
+		})
+	}
+}
+
 // TestCellString verifies Cell String() method
 func TestCellString(t *testing.T) {
 	tests := []struct {
@@ -318,3 +340,58 @@ func TestMakeMoveOccupiedCell(t *testing.T) {
 		t.Error("MakeMove on occupied cell should return error")
 	}
 }
+
+// TestNewBoardSize verifies boards of arbitrary dimensions
+func TestNewBoardSize(t *testing.T) {
+	board := NewBoardSize(5, 4)
+
+	if board.Width() != 5 || board.Height() != 4 {
+		t.Fatalf("NewBoardSize(5, 4) is %dx%d, want 5x4", board.Width(), board.Height())
+	}
+	if board.CountOccupied() != 0 {
+		t.Errorf("NewBoardSize(5, 4) has %d occupied cells, want 0", board.CountOccupied())
+	}
+}
+
+// TestBoardInBounds verifies bounds checks use the board's own dimensions
+func TestBoardInBounds(t *testing.T) {
+	board := NewBoardSize(4, 2)
+
+	tests := []struct {
+		name string
+		row  int
+		col  int
+		want bool
+	}{
+		{"Top-left", 0, 0, true},
+		{"Bottom-right", 1, 3, true},
+		{"Row past height", 2, 0, false},
+		{"Col past width", 0, 4, false},
+		{"Negative", -1, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := board.InBounds(tt.row, tt.col); got != tt.want {
+				t.Errorf("InBounds(%d, %d) = %v, want %v", tt.row, tt.col, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestBoardSetCellDoesNotShareRows verifies SetCell copies every row
+func TestBoardSetCellDoesNotShareRows(t *testing.T) {
+	board := NewBoardSize(4, 4)
+	first := board.SetCell(0, 0, X)
+	second := first.SetCell(3, 3, O)
+
+	if first.GetCell(3, 3) != Empty {
+		t.Error("SetCell modified the board it was called on")
+	}
+	if !second.Equal(first.SetCell(3, 3, O)) {
+		t.Error("Equal() = false for boards with identical cells")
+	}
+	if first.Equal(second) {
+		t.Error("Equal() = true for boards with different cells")
+	}
+}
@@ -0,0 +1,131 @@
+package game
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DefaultWinLength is the number of marks in a row needed to win the classic game
+const DefaultWinLength = 3
+
+// MaxDimension is the largest supported board width or height
+// It matches the number of column letters available in game notation (a-z)
+const MaxDimension = 26
+
+// ErrInvalidConfig indicates board dimensions or a win length that cannot be played
+var ErrInvalidConfig = &GameError{"Invalid board configuration"}
+
+// Config describes an m,n,k-game: a Width x Height board where WinLength in a
+// row completes a line, and the Variant deciding whether that wins or loses
+// A Config with zero dimensions behaves like StandardConfig
+type Config struct {
+	Width     int     // Number of columns
+	Height    int     // Number of rows
+	WinLength int     // Marks in a row needed to complete a line
+	Variant   Variant // Whether completing a line wins or loses
+}
+
+// StandardConfig returns the configuration of classic 3x3 tic-tac-toe
+func StandardConfig() Config {
+	return Config{Width: BOARD_SIZE, Height: BOARD_SIZE, WinLength: DefaultWinLength}
+}
+
+// Validate checks that the board fits within MaxDimension, that
+// a line of WinLength marks fits on it and that the variant is known
+func (c Config) Validate() error {
+	if c.Width < 1 || c.Width > MaxDimension || c.Height < 1 || c.Height > MaxDimension {
+		return fmt.Errorf("%w: board must be between 1x1 and %dx%d, got %dx%d",
+			ErrInvalidConfig, MaxDimension, MaxDimension, c.Width, c.Height)
+	}
+	if c.WinLength < 1 || c.WinLength > max(c.Width, c.Height) {
+		return fmt.Errorf("%w: win length must be between 1 and %d, got %d",
+			ErrInvalidConfig, max(c.Width, c.Height), c.WinLength)
+	}
+	if !c.Variant.Valid() {
+		return fmt.Errorf("%w: unknown variant %d", ErrInvalidConfig, int(c.Variant))
+	}
+	return nil
+}
+
+// IsStandard returns true if c describes classic 3x3 tic-tac-toe
+func (c Config) IsStandard() bool {
+	return c.normalized() == StandardConfig()
+}
+
+// String returns the configuration in WIDTHxHEIGHT/K form, e.g. "15x15/5",
+// followed by the variant unless it is Standard, e.g. "3x3/3 misere"
+func (c Config) String() string {
+	c = c.normalized()
+	s := fmt.Sprintf("%dx%d/%d", c.Width, c.Height, c.WinLength)
+	if c.Variant != Standard {
+		s += " " + c.Variant.String()
+	}
+	return s
+}
+
+// ParseConfig parses a configuration written by String, e.g. "15x15/5" or "3x3/3 misere"
+// The result is validated
+func ParseConfig(s string) (Config, error) {
+	malformed := fmt.Errorf("%w: %q is not in WIDTHxHEIGHT/K form", ErrInvalidConfig, s)
+	dimensions, variant, hasVariant := strings.Cut(s, " ")
+	size, win, ok := strings.Cut(dimensions, "/")
+	if !ok {
+		return Config{}, malformed
+	}
+	width, height, ok := strings.Cut(size, "x")
+	if !ok {
+		return Config{}, malformed
+	}
+
+	var c Config
+	var errW, errH, errK error
+	c.Width, errW = strconv.Atoi(width)
+	c.Height, errH = strconv.Atoi(height)
+	c.WinLength, errK = strconv.Atoi(win)
+	if errW != nil || errH != nil || errK != nil {
+		return Config{}, malformed
+	}
+	if hasVariant {
+		var err error
+		if c.Variant, err = ParseVariant(variant); err != nil {
+			return Config{}, fmt.Errorf("%w: %v", ErrInvalidConfig, err)
+		}
+	}
+	return c, c.Validate()
+}
+
+// CheckWin determines if player has WinLength marks in a row on board
+// It reports the line whatever the Variant; see Outcome for who it counts for
+func (c Config) CheckWin(board Board, player Cell) bool {
+	return CheckWinLength(board, player, c.normalized().WinLength)
+}
+
+// CheckDraw determines if board is full with no WinLength line for either player
+func (c Config) CheckDraw(board Board) bool {
+	return board.IsFull() && !c.CheckWin(board, X) && !c.CheckWin(board, O)
+}
+
+// Outcome returns the game state once player completes a line: a win for
+// player under Standard and Wild rules and a win for the opponent under Misere
+// Under Wild rules the line may be of either mark; it counts for the player
+// who moved
+func (c Config) Outcome(player Player) GameState {
+	if c.Variant == Misere {
+		player = player.Other()
+	}
+	if player == Player1 {
+		return Player1Won
+	}
+	return Player2Won
+}
+
+// normalized replaces zero dimensions with those of StandardConfig, keeping the variant
+func (c Config) normalized() Config {
+	if c.Width == 0 && c.Height == 0 && c.WinLength == 0 {
+		standard := StandardConfig()
+		standard.Variant = c.Variant
+		return standard
+	}
+	return c
+}
@@ -0,0 +1,154 @@
+package game
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestConfigValidate verifies unplayable configurations are rejected
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    Config
+		wantError bool
+	}{
+		{"Standard 3x3", StandardConfig(), false},
+		{"Gomoku 15x15 five in a row", Config{Width: 15, Height: 15, WinLength: 5}, false},
+		{"Rectangular 7x6 four in a row", Config{Width: 7, Height: 6, WinLength: 4}, false},
+		{"Zero width", Config{Width: 0, Height: 3, WinLength: 3}, true},
+		{"Too tall", Config{Width: 3, Height: MaxDimension + 1, WinLength: 3}, true},
+		{"Win length longer than board", Config{Width: 4, Height: 4, WinLength: 5}, true},
+		{"Zero win length", Config{Width: 3, Height: 3, WinLength: 0}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.wantError && !errors.Is(err, ErrInvalidConfig) {
+				t.Errorf("Validate() error = %v, want ErrInvalidConfig", err)
+			}
+			if !tt.wantError && err != nil {
+				t.Errorf("Validate() unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+// TestConfigString verifies the WIDTHxHEIGHT/K form
+func TestConfigString(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+		want   string
+	}{
+		{"Standard", StandardConfig(), "3x3/3"},
+		{"Zero value is standard", Config{}, "3x3/3"},
+		{"Gomoku", Config{Width: 15, Height: 15, WinLength: 5}, "15x15/5"},
+		{"Misere", Config{Variant: Misere}, "3x3/3 misere"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.config.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestParseConfig verifies configurations round-trip through String and bad input is rejected
+func TestParseConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Config
+		wantErr bool
+	}{
+		{"Standard", "3x3/3", StandardConfig(), false},
+		{"Rectangular", "7x6/4", Config{Width: 7, Height: 6, WinLength: 4}, false},
+		{"Misere", "4x4/3 misere", Config{Width: 4, Height: 4, WinLength: 3, Variant: Misere}, false},
+		{"Unknown variant", "3x3/3 reverse", Config{}, true},
+		{"Missing win length", "3x3", Config{}, true},
+		{"Missing height", "3/3", Config{}, true},
+		{"Not a number", "ax3/3", Config{}, true},
+		{"Unplayable", "3x3/4", Config{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseConfig(tt.input)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidConfig) {
+					t.Errorf("ParseConfig(%q) error = %v, want ErrInvalidConfig", tt.input, err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("ParseConfig(%q) = %v, %v; want %v", tt.input, got, err, tt.want)
+			}
+			if got.String() != tt.input {
+				t.Errorf("String() = %q, want %q", got.String(), tt.input)
+			}
+		})
+	}
+}
+
+// TestConfigLines verifies every winning line is generated exactly once
+func TestConfigLines(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+		want   int
+	}{
+		{"Standard 3x3 has 8 lines", StandardConfig(), 8},
+		{"4x4 four in a row has 10 lines", Config{Width: 4, Height: 4, WinLength: 4}, 10},
+		{"4x4 three in a row has 24 lines", Config{Width: 4, Height: 4, WinLength: 3}, 24},
+		{"One in a row has one line per cell", Config{Width: 2, Height: 2, WinLength: 1}, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := tt.config.Lines()
+			if len(lines) != tt.want {
+				t.Errorf("len(Lines()) = %d, want %d", len(lines), tt.want)
+			}
+			for _, line := range lines {
+				if len(line) != tt.config.WinLength {
+					t.Errorf("line %v has %d cells, want %d", line, len(line), tt.config.WinLength)
+				}
+			}
+		})
+	}
+}
+
+// TestNewGameWithConfig verifies games use the configured board and win length
+func TestNewGameWithConfig(t *testing.T) {
+	g, err := NewGameWithConfig(Config{Width: 4, Height: 4, WinLength: 4})
+	if err != nil {
+		t.Fatalf("NewGameWithConfig() returned error: %v", err)
+	}
+	if g.Board.Width() != 4 || g.Board.Height() != 4 {
+		t.Fatalf("Board is %dx%d, want 4x4", g.Board.Width(), g.Board.Height())
+	}
+
+	// Three in a row is not enough on a four-in-a-row board
+	for _, move := range [][2]int{{0, 0}, {1, 0}, {0, 1}, {1, 1}, {0, 2}, {1, 2}} {
+		if g, err = g.MakeMove(move[0], move[1]); err != nil {
+			t.Fatalf("MakeMove(%d, %d) returned error: %v", move[0], move[1], err)
+		}
+	}
+	if g.State != InProgress {
+		t.Fatalf("State after three in a row = %v, want InProgress", g.State)
+	}
+
+	if g, err = g.MakeMove(0, 3); err != nil {
+		t.Fatalf("MakeMove(0, 3) returned error: %v", err)
+	}
+	if g.State != Player1Won {
+		t.Errorf("State after four in a row = %v, want Player1Won", g.State)
+	}
+
+	if _, err := NewGameWithConfig(Config{Width: 3, Height: 3, WinLength: 4}); !errors.Is(err, ErrInvalidConfig) {
+		t.Errorf("NewGameWithConfig(3x3/4) error = %v, want ErrInvalidConfig", err)
+	}
+}
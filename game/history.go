@@ -0,0 +1,69 @@
+package game
+
+// Move records a single move and the game state it produced
+type Move struct {
+	Player Player    // Player who made the move
+	Row    int       // Row of the placed mark
+	Col    int       // Column of the placed mark
+	Mark   Cell      // Mark placed: the player's own except in the Wild variant
+	State  GameState // Game state after the move
+}
+
+// Error types for history navigation
+var (
+	ErrNothingToUndo = &GameError{"Nothing to undo. No moves have been made"}
+	ErrNothingToRedo = &GameError{"Nothing to redo. No moves have been undone"}
+)
+
+// Undo returns a new game with the most recent move taken back
+// The undone move can be replayed with Redo until a new move is made
+// Returns ErrNothingToUndo if the history is empty
+func (g Game) Undo() (Game, error) {
+	n := len(g.History)
+	if n == 0 {
+		return g, ErrNothingToUndo
+	}
+	last := g.History[n-1]
+
+	// Create new game state (immutable)
+	newGame := g
+	newGame.Board = g.Board.SetCell(last.Row, last.Col, Empty)
+	newGame.CurrentPlayer = last.Player
+	newGame.State = InProgress
+	newGame.MoveCount--
+
+	// Full slice expressions force append to copy instead of sharing storage
+	newGame.History = g.History[: n-1 : n-1]
+	newGame.Undone = append(g.Undone[:len(g.Undone):len(g.Undone)], last)
+
+	return newGame, nil
+}
+
+// Redo returns a new game with the most recently undone move replayed
+// Returns ErrNothingToRedo if no moves have been undone
+func (g Game) Redo() (Game, error) {
+	n := len(g.Undone)
+	if n == 0 {
+		return g, ErrNothingToRedo
+	}
+	next := g.Undone[n-1]
+
+	newGame, err := g.MakeMoveMark(next.Row, next.Col, next.Mark)
+	if err != nil {
+		return g, err
+	}
+
+	// MakeMove starts a new line of play, so restore the remaining redo moves
+	newGame.Undone = g.Undone[: n-1 : n-1]
+	return newGame, nil
+}
+
+// CanUndo returns true if there is a move to take back
+func (g Game) CanUndo() bool {
+	return len(g.History) > 0
+}
+
+// CanRedo returns true if there is an undone move to replay
+func (g Game) CanRedo() bool {
+	return len(g.Undone) > 0
+}
@@ -0,0 +1,151 @@
+package game
+
+import (
+	"errors"
+	"testing"
+)
+
+// playMoves applies a sequence of moves to a new game
+func playMoves(t *testing.T, moves [][2]int) Game {
+	t.Helper()
+	g := NewGame()
+	for i, move := range moves {
+		var err error
+		g, err = g.MakeMove(move[0], move[1])
+		if err != nil {
+			t.Fatalf("Move %d at (%d,%d) failed: %v", i+1, move[0], move[1], err)
+		}
+	}
+	return g
+}
+
+// TestMakeMoveRecordsHistory verifies each move is logged with its resulting state
+func TestMakeMoveRecordsHistory(t *testing.T) {
+	g := playMoves(t, [][2]int{{0, 0}, {1, 0}, {0, 1}, {1, 1}, {0, 2}})
+
+	want := []Move{
+		{Player1, 0, 0, X, InProgress},
+		{Player2, 1, 0, O, InProgress},
+		{Player1, 0, 1, X, InProgress},
+		{Player2, 1, 1, O, InProgress},
+		{Player1, 0, 2, X, Player1Won},
+	}
+	if len(g.History) != len(want) {
+		t.Fatalf("len(History) = %d, want %d", len(g.History), len(want))
+	}
+	for i, move := range want {
+		if g.History[i] != move {
+			t.Errorf("History[%d] = %+v, want %+v", i, g.History[i], move)
+		}
+	}
+}
+
+// TestUndo verifies Undo restores the previous position without mutating the original
+func TestUndo(t *testing.T) {
+	before := playMoves(t, [][2]int{{1, 1}})
+	after, err := before.MakeMove(0, 0)
+	if err != nil {
+		t.Fatalf("MakeMove(0, 0) returned error: %v", err)
+	}
+
+	undone, err := after.Undo()
+	if err != nil {
+		t.Fatalf("Undo() returned error: %v", err)
+	}
+
+	if !undone.Board.Equal(before.Board) {
+		t.Errorf("Undo() board = %v, want %v", undone.Board, before.Board)
+	}
+	if undone.CurrentPlayer != Player2 {
+		t.Errorf("Undo() CurrentPlayer = %v, want Player2", undone.CurrentPlayer)
+	}
+	if undone.MoveCount != 1 || len(undone.History) != 1 {
+		t.Errorf("Undo() MoveCount = %d, len(History) = %d, want 1 and 1", undone.MoveCount, len(undone.History))
+	}
+	if !undone.CanRedo() {
+		t.Error("Undo() should allow Redo()")
+	}
+
+	// Original game is unchanged (immutability)
+	if after.Board.GetCell(0, 0) != O || len(after.History) != 2 || after.CanRedo() {
+		t.Error("Undo() modified the original game")
+	}
+}
+
+// TestUndoFinishedGame verifies Undo reopens a won game
+func TestUndoFinishedGame(t *testing.T) {
+	g := playMoves(t, [][2]int{{0, 0}, {1, 0}, {0, 1}, {1, 1}, {0, 2}})
+
+	g, err := g.Undo()
+	if err != nil {
+		t.Fatalf("Undo() returned error: %v", err)
+	}
+	if g.State != InProgress || g.CurrentPlayer != Player1 {
+		t.Errorf("Undo() = (%v, %v), want (InProgress, Player1)", g.State, g.CurrentPlayer)
+	}
+}
+
+// TestRedo verifies Redo replays undone moves in order
+func TestRedo(t *testing.T) {
+	original := playMoves(t, [][2]int{{0, 0}, {1, 1}, {2, 2}})
+
+	g := original
+	for i := 0; i < 3; i++ {
+		var err error
+		if g, err = g.Undo(); err != nil {
+			t.Fatalf("Undo() %d returned error: %v", i+1, err)
+		}
+	}
+	for i := 0; i < 3; i++ {
+		var err error
+		if g, err = g.Redo(); err != nil {
+			t.Fatalf("Redo() %d returned error: %v", i+1, err)
+		}
+	}
+
+	if !g.Board.Equal(original.Board) || g.CurrentPlayer != original.CurrentPlayer || g.MoveCount != original.MoveCount {
+		t.Errorf("Undo/Redo round trip = %+v, want %+v", g, original)
+	}
+	if g.CanRedo() {
+		t.Error("CanRedo() = true after redoing every move")
+	}
+}
+
+// TestMakeMoveClearsRedo verifies a new move discards undone moves
+func TestMakeMoveClearsRedo(t *testing.T) {
+	g := playMoves(t, [][2]int{{0, 0}, {1, 1}})
+	g, _ = g.Undo()
+
+	g, err := g.MakeMove(2, 2)
+	if err != nil {
+		t.Fatalf("MakeMove(2, 2) returned error: %v", err)
+	}
+	if _, err := g.Redo(); !errors.Is(err, ErrNothingToRedo) {
+		t.Errorf("Redo() error = %v, want ErrNothingToRedo", err)
+	}
+}
+
+// TestHistoryIsNotShared verifies branching games do not overwrite each other's history
+func TestHistoryIsNotShared(t *testing.T) {
+	base := playMoves(t, [][2]int{{0, 0}, {1, 1}})
+	base, _ = base.Undo()
+
+	left, _ := base.MakeMove(0, 1)
+	right, _ := base.MakeMove(2, 2)
+
+	if left.History[1].Col != 1 || right.History[1].Col != 2 {
+		t.Errorf("Branch histories overlap: left %+v, right %+v", left.History, right.History)
+	}
+}
+
+// TestUndoRedoEmpty verifies errors when there is nothing to undo or redo
+func TestUndoRedoEmpty(t *testing.T) {
+	g := NewGame()
+
+	if _, err := g.Undo(); !errors.Is(err, ErrNothingToUndo) {
+		t.Errorf("Undo() error = %v, want ErrNothingToUndo", err)
+	}
+	if _, err := g.Redo(); !errors.Is(err, ErrNothingToRedo) {
+		t.Errorf("Redo() error = %v, want ErrNothingToRedo", err)
+	}
+}
@@ -0,0 +1,135 @@
+package game
+
+import "fmt"
+
+// Error types for match play
+var (
+	ErrInvalidBestOf    = &GameError{"Invalid match length. Best-of must be at least 1"}
+	ErrMatchOver        = &GameError{"The match is already decided"}
+	ErrGameNotFinished  = &GameError{"Only finished games can be recorded"}
+	ErrGameConfigChange = &GameError{"Every game of a match must use the match's board configuration"}
+)
+
+// Match is a series of up to BestOf games between the same two players
+// The first mover alternates: Player 1 starts the first game, Player 2 the second, and so on
+type Match struct {
+	Config  Config      // Board configuration for every game
+	BestOf  int         // Maximum number of games in the series
+	Results []GameState // Outcome of each finished game, in order
+}
+
+// NewMatch creates a best-of series played with config
+// Returns ErrInvalidBestOf if bestOf is less than 1 and ErrInvalidConfig
+// if the configuration cannot be played
+func NewMatch(config Config, bestOf int) (Match, error) {
+	if bestOf < 1 {
+		return Match{}, ErrInvalidBestOf
+	}
+	if err := config.Validate(); err != nil {
+		return Match{}, err
+	}
+	return Match{Config: config.normalized(), BestOf: bestOf}, nil
+}
+
+// GamesPlayed returns the number of games recorded so far
+func (m Match) GamesPlayed() int {
+	return len(m.Results)
+}
+
+// FirstPlayer returns who moves first in the game with the given zero-based index
+func (m Match) FirstPlayer(index int) Player {
+	if index%2 == 0 {
+		return Player1
+	}
+	return Player2
+}
+
+// NextGame returns a fresh game for the next game of the series, with the
+// alternating first mover to play
+// Returns ErrMatchOver once the series is decided
+func (m Match) NextGame() (Game, error) {
+	if m.IsOver() {
+		return Game{}, ErrMatchOver
+	}
+	g, err := NewGameWithConfig(m.Config)
+	if err != nil {
+		return Game{}, err
+	}
+	g.CurrentPlayer = m.FirstPlayer(m.GamesPlayed())
+	return g, nil
+}
+
+// Record returns a new match with the result of the finished game g added
+// Returns ErrMatchOver if the series is already decided, ErrGameNotFinished
+// if g is still in progress and ErrGameConfigChange if g was played on a
+// different board
+func (m Match) Record(g Game) (Match, error) {
+	if m.IsOver() {
+		return m, ErrMatchOver
+	}
+	if g.State == InProgress {
+		return m, ErrGameNotFinished
+	}
+	if g.Config.normalized() != m.Config {
+		return m, fmt.Errorf("%w: game is %s, match is %s", ErrGameConfigChange, g.Config, m.Config)
+	}
+
+	newMatch := m
+	// Full slice expression forces append to copy instead of sharing storage
+	newMatch.Results = append(m.Results[:len(m.Results):len(m.Results)], g.State)
+	return newMatch, nil
+}
+
+// Wins returns the number of games p has won
+func (m Match) Wins(p Player) int {
+	won := Player1Won
+	if p == Player2 {
+		won = Player2Won
+	}
+	return m.count(won)
+}
+
+// Losses returns the number of games p has lost
+func (m Match) Losses(p Player) int {
+	return m.Wins(p.Other())
+}
+
+// Draws returns the number of drawn games
+func (m Match) Draws() int {
+	return m.count(Draw)
+}
+
+// count returns how many recorded games ended in state
+func (m Match) count(state GameState) int {
+	n := 0
+	for _, result := range m.Results {
+		if result == state {
+			n++
+		}
+	}
+	return n
+}
+
+// IsOver reports whether the series is decided: all BestOf games have been
+// played, or one player leads by more games than remain
+func (m Match) IsOver() bool {
+	remaining := m.BestOf - m.GamesPlayed()
+	lead := m.Wins(Player1) - m.Wins(Player2)
+	return remaining <= 0 || lead > remaining || -lead > remaining
+}
+
+// Winner returns the player who won the series
+// Returns false while the series is undecided and when it ends level
+func (m Match) Winner() (Player, bool) {
+	if !m.IsOver() {
+		return Player1, false
+	}
+	switch p1, p2 := m.Wins(Player1), m.Wins(Player2); {
+	case p1 > p2:
+		return Player1, true
+	case p2 > p1:
+		return Player2, true
+	default:
+		return Player1, false
+	}
+}
@@ -0,0 +1,157 @@
+package game
+
+import (
+	"errors"
+	"testing"
+)
+
+// finishedGame returns a finished standard game in the given state
+func finishedGame(state GameState) Game {
+	g := NewGame()
+	g.State = state
+	return g
+}
+
+// TestNewMatch verifies match length and configuration validation
+func TestNewMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		bestOf  int
+		wantErr error
+	}{
+		{"Single game", StandardConfig(), 1, nil},
+		{"Best of five", Config{Width: 4, Height: 4, WinLength: 3}, 5, nil},
+		{"Zero games", StandardConfig(), 0, ErrInvalidBestOf},
+		{"Invalid board", Config{Width: 2, Height: 2, WinLength: 3}, 3, ErrInvalidConfig},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := NewMatch(tt.config, tt.bestOf)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewMatch() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && (m.BestOf != tt.bestOf || m.GamesPlayed() != 0) {
+				t.Errorf("NewMatch() = %+v", m)
+			}
+		})
+	}
+}
+
+// TestMatchAlternatesFirstPlayer verifies each new game starts with the other player
+func TestMatchAlternatesFirstPlayer(t *testing.T) {
+	m, _ := NewMatch(StandardConfig(), 5)
+	want := []Player{Player1, Player2, Player1, Player2}
+
+	for i, first := range want {
+		g, err := m.NextGame()
+		if err != nil {
+			t.Fatalf("NextGame() %d returned error: %v", i+1, err)
+		}
+		if g.CurrentPlayer != first {
+			t.Errorf("Game %d first player = %v, want %v", i+1, g.CurrentPlayer, first)
+		}
+		if m, err = m.Record(finishedGame(Draw)); err != nil {
+			t.Fatalf("Record() returned error: %v", err)
+		}
+	}
+}
+
+// TestMatchSecondGameIsPlayable verifies Player 2 can open a game and the position stays valid
+func TestMatchSecondGameIsPlayable(t *testing.T) {
+	m, _ := NewMatch(StandardConfig(), 3)
+	m, _ = m.Record(finishedGame(Player1Won))
+	g, _ := m.NextGame()
+
+	g, err := g.MakeMove(1, 1)
+	if err != nil {
+		t.Fatalf("MakeMove() returned error: %v", err)
+	}
+	if g.Board.GetCell(1, 1) != O || g.CurrentPlayer != Player1 {
+		t.Errorf("After first move cell = %v, current = %v; want O placed and X to move", g.Board.GetCell(1, 1), g.CurrentPlayer)
+	}
+	if err := ValidatePosition(g); err != nil {
+		t.Errorf("ValidatePosition() returned error: %v", err)
+	}
+}
+
+// TestMatchScoring verifies win, loss and draw tallies and the series outcome
+func TestMatchScoring(t *testing.T) {
+	tests := []struct {
+		name        string
+		bestOf      int
+		results     []GameState
+		wantOver    bool
+		wantWinner  Player
+		wantDecided bool
+	}{
+		{"Undecided", 3, []GameState{Player1Won}, false, Player1, false},
+		{"Clinched early", 3, []GameState{Player2Won, Player2Won}, true, Player2, true},
+		{"Lead larger than games left", 5, []GameState{Player1Won, Draw, Player1Won, Draw}, true, Player1, true},
+		{"Decided in last game", 3, []GameState{Player1Won, Player2Won, Player2Won}, true, Player2, true},
+		{"Level after all games", 3, []GameState{Player1Won, Player2Won, Draw}, true, Player1, false},
+		{"All draws", 1, []GameState{Draw}, true, Player1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, _ := NewMatch(StandardConfig(), tt.bestOf)
+			for _, result := range tt.results {
+				var err error
+				if m, err = m.Record(finishedGame(result)); err != nil {
+					t.Fatalf("Record(%v) returned error: %v", result, err)
+				}
+			}
+
+			if got := m.IsOver(); got != tt.wantOver {
+				t.Errorf("IsOver() = %v, want %v", got, tt.wantOver)
+			}
+			winner, decided := m.Winner()
+			if decided != tt.wantDecided || (decided && winner != tt.wantWinner) {
+				t.Errorf("Winner() = %v, %v; want %v, %v", winner, decided, tt.wantWinner, tt.wantDecided)
+			}
+			if m.Wins(Player1) != m.Losses(Player2) || m.Wins(Player2) != m.Losses(Player1) {
+				t.Errorf("Wins and losses disagree: %+v", m)
+			}
+			if got := m.Wins(Player1) + m.Wins(Player2) + m.Draws(); got != len(tt.results) {
+				t.Errorf("Tallies sum to %d, want %d", got, len(tt.results))
+			}
+		})
+	}
+}
+
+// TestMatchRecordErrors verifies Record rejects unfinished games, other boards and decided matches
+func TestMatchRecordErrors(t *testing.T) {
+	m, _ := NewMatch(StandardConfig(), 1)
+
+	if _, err := m.Record(NewGame()); !errors.Is(err, ErrGameNotFinished) {
+		t.Errorf("Record(unfinished) error = %v, want ErrGameNotFinished", err)
+	}
+
+	other, _ := NewGameWithConfig(Config{Width: 4, Height: 4, WinLength: 4})
+	other.State = Draw
+	if _, err := m.Record(other); !errors.Is(err, ErrGameConfigChange) {
+		t.Errorf("Record(4x4 game) error = %v, want ErrGameConfigChange", err)
+	}
+
+	m, _ = m.Record(finishedGame(Draw))
+	if _, err := m.Record(finishedGame(Draw)); !errors.Is(err, ErrMatchOver) {
+		t.Errorf("Record() after match over error = %v, want ErrMatchOver", err)
+	}
+	if _, err := m.NextGame(); !errors.Is(err, ErrMatchOver) {
+		t.Errorf("NextGame() after match over error = %v, want ErrMatchOver", err)
+	}
+}
+
+// TestMatchRecordDoesNotShareResults verifies recording on a copy leaves the original unchanged
+func TestMatchRecordDoesNotShareResults(t *testing.T) {
+	m, _ := NewMatch(StandardConfig(), 5)
+	m, _ = m.Record(finishedGame(Draw))
+
+	a, _ := m.Record(finishedGame(Player1Won))
+	b, _ := m.Record(finishedGame(Player2Won))
+	if a.Results[1] != Player1Won || b.Results[1] != Player2Won || m.GamesPlayed() != 1 {
+		t.Errorf("Results shared between copies: a=%v b=%v m=%v", a.Results, b.Results, m.Results)
+	}
+}
@@ -0,0 +1,42 @@
+package numerical
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+	"github.com/YOUR_USERNAME/tictactoe/validation"
+)
+
+// ParseMove parses a move written as a number, the word "at", then row and
+// column, e.g. "5 at 1 1"
+// The number must be between 1 and 9 and the row and column between 0 and 2;
+// whose number it is and whether it is still free is checked by MakeMove
+func ParseMove(input string) (int, game.Position, error) {
+	fields := strings.Fields(input)
+	switch {
+	case len(fields) < 4:
+		return 0, game.Position{}, fmt.Errorf("%w: enter a number, 'at', then row and column, e.g. '5 at 1 1'",
+			validation.ErrIncompleteInput)
+	case len(fields) > 4:
+		return 0, game.Position{}, fmt.Errorf("%w: expected a number, 'at', row and column, got %d words",
+			validation.ErrInvalidFormat, len(fields))
+	case !strings.EqualFold(fields[1], "at"):
+		return 0, game.Position{}, fmt.Errorf("%w: expected 'at' after the number, got %q",
+			validation.ErrInvalidFormat, fields[1])
+	}
+
+	n, err := validation.ValidateNumeric(fields[0])
+	if err != nil {
+		return 0, game.Position{}, err
+	}
+	if n < 1 || n > MaxNumber {
+		return 0, game.Position{}, ErrInvalidNumber
+	}
+
+	row, col, err := validation.ParseAndValidateInputSize(fields[2]+" "+fields[3], Size, Size)
+	if err != nil {
+		return 0, game.Position{}, err
+	}
+	return n, game.Position{Row: row, Col: col}, nil
+}
@@ -0,0 +1,44 @@
+package numerical
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+	"github.com/YOUR_USERNAME/tictactoe/validation"
+)
+
+// TestParseMove verifies "N at ROW COL" move input
+func TestParseMove(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		wantNumber int
+		want       game.Position
+		wantErr    error
+	}{
+		{"Number at row and column", "5 at 1 1", 5, pos(1, 1), nil},
+		{"Extra spaces and capitals", "  9 AT 0   2 ", 9, pos(0, 2), nil},
+		{"Empty", "", 0, game.Position{}, validation.ErrIncompleteInput},
+		{"Missing at", "5 1 1", 0, game.Position{}, validation.ErrIncompleteInput},
+		{"Wrong word", "5 on 1 1", 0, game.Position{}, validation.ErrInvalidFormat},
+		{"Too many words", "5 at 1 1 1", 0, game.Position{}, validation.ErrInvalidFormat},
+		{"Number not numeric", "five at 1 1", 0, game.Position{}, validation.ErrInvalidFormat},
+		{"Number too large", "10 at 1 1", 0, game.Position{}, ErrInvalidNumber},
+		{"Number zero", "0 at 1 1", 0, game.Position{}, ErrInvalidNumber},
+		{"Row off the board", "5 at 3 1", 0, game.Position{}, validation.ErrInvalidRange},
+		{"Column not numeric", "5 at 1 x", 0, game.Position{}, validation.ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, got, err := ParseMove(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseMove(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+			}
+			if n != tt.wantNumber || got != tt.want {
+				t.Errorf("ParseMove(%q) = %d, %v; want %d, %v", tt.input, n, got, tt.wantNumber, tt.want)
+			}
+		})
+	}
+}
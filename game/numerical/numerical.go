@@ -0,0 +1,192 @@
+// Package numerical implements Numerical Tic-Tac-Toe, also called the Fifteen game
+//
+// Player 1 places the odd numbers 1, 3, 5, 7 and 9 and Player 2 the even
+// numbers 2, 4, 6 and 8, each number at most once. A player wins by
+// completing a row, column or diagonal of three numbers that sum to 15,
+// whichever player placed the other numbers in it
+package numerical
+
+import (
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// Size is the number of cells along each side of the board
+const Size = game.BOARD_SIZE
+
+// Target is the sum a completed line needs to win
+const Target = 15
+
+// MaxNumber is the largest number that can be placed; the smallest is 1
+const MaxNumber = Size * Size
+
+// Error types for numerical moves
+var (
+	ErrGameOver      = &game.GameError{Message: "The game is over. No more moves can be played"}
+	ErrInvalidNumber = &game.GameError{Message: "Invalid number. Numbers must be between 1 and 9"}
+	ErrWrongParity   = &game.GameError{Message: "Wrong number. Player 1 places odd numbers and Player 2 even numbers"}
+	ErrNumberUsed    = &game.GameError{Message: "Number already used. Each number can be placed only once"}
+)
+
+// Cell holds the number placed in a cell, or Empty
+type Cell int
+
+// Empty represents an unoccupied cell
+const Empty Cell = 0
+
+// String returns the number in the cell, or a space if it is empty
+func (c Cell) String() string {
+	if c == Empty {
+		return " "
+	}
+	return string(rune('0' + c))
+}
+
+// Board is the 3x3 grid of numbers
+// It is an array, so copying a Board copies its cells
+type Board [Size][Size]Cell
+
+// lines holds every winning line of the board, computed once
+var lines = game.StandardConfig().Lines()
+
+// Lines returns the rows, columns and diagonals of the board
+// The returned slice is shared and must not be modified
+func Lines() [][]game.Position {
+	return lines
+}
+
+// Owns returns true if player places n: odd numbers for Player 1, even for Player 2
+func Owns(player game.Player, n int) bool {
+	return (n%2 == 1) == (player == game.Player1)
+}
+
+// PlayerName returns the display name for player, naming the numbers they place
+// instead of the X or O mark used by game.Player.Name
+func PlayerName(player game.Player) string {
+	switch player {
+	case game.Player1:
+		return "Player 1 (odd)"
+	case game.Player2:
+		return "Player 2 (even)"
+	default:
+		return player.Name()
+	}
+}
+
+// Move records a single numerical move and the game state it produced
+type Move struct {
+	Player        game.Player    // Player who made the move
+	Number        int            // Number placed
+	game.Position                // Cell played
+	State         game.GameState // Game state after the move
+}
+
+// Game represents a numerical game
+// Like game.Game it is an immutable value: MakeMove returns a new Game
+type Game struct {
+	Board         Board          // Numbers placed so far
+	CurrentPlayer game.Player    // Whose turn it is; Player 1 places the odd numbers
+	State         game.GameState // Current game status
+	MoveCount     int            // Number of moves made (0 to 9)
+	History       []Move         // Moves played so far, oldest first
+}
+
+// NewGame creates a new numerical game with Player 1, who places the odd numbers, to move
+func NewGame() Game {
+	return Game{CurrentPlayer: game.Player1, State: game.InProgress}
+}
+
+// Cell returns the contents of the cell at p
+func (g Game) Cell(p game.Position) Cell {
+	return g.Board[p.Row][p.Col]
+}
+
+// Used returns true if n has already been placed
+func (g Game) Used(n int) bool {
+	for _, row := range g.Board {
+		for _, cell := range row {
+			if cell == Cell(n) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Available returns the numbers player may still place, smallest first
+func (g Game) Available(player game.Player) []int {
+	var numbers []int
+	for n := 1; n <= MaxNumber; n++ {
+		if Owns(player, n) && !g.Used(n) {
+			numbers = append(numbers, n)
+		}
+	}
+	return numbers
+}
+
+// MakeMove places number n at p for the current player and returns the new game state
+// Returns ErrGameOver once the game is finished, ErrInvalidNumber for a number
+// outside 1 to 9, ErrWrongParity for the opponent's number, ErrNumberUsed for
+// a number already on the board, game.ErrInvalidRange for a position off the
+// board and game.ErrCellOccupied for a taken cell
+func (g Game) MakeMove(n int, p game.Position) (Game, error) {
+	switch {
+	case g.State != game.InProgress:
+		return g, ErrGameOver
+	case n < 1 || n > MaxNumber:
+		return g, ErrInvalidNumber
+	case !Owns(g.CurrentPlayer, n):
+		return g, ErrWrongParity
+	case g.Used(n):
+		return g, ErrNumberUsed
+	case p.Row < 0 || p.Row >= Size || p.Col < 0 || p.Col >= Size:
+		return g, game.ErrInvalidRange
+	case g.Cell(p) != Empty:
+		return g, game.ErrCellOccupied
+	}
+
+	// Board is an array, so the assignment copies it
+	newGame := g
+	newGame.Board[p.Row][p.Col] = Cell(n)
+	newGame.MoveCount++
+
+	switch {
+	case newGame.CheckWin():
+		if g.CurrentPlayer == game.Player1 {
+			newGame.State = game.Player1Won
+		} else {
+			newGame.State = game.Player2Won
+		}
+	case newGame.MoveCount == Size*Size:
+		newGame.State = game.Draw
+	default:
+		newGame.CurrentPlayer = g.CurrentPlayer.Other()
+	}
+
+	move := Move{Player: g.CurrentPlayer, Number: n, Position: p, State: newGame.State}
+	newGame.History = append(g.History[:len(g.History):len(g.History)], move)
+	return newGame, nil
+}
+
+// CheckWin returns true if any full line sums to Target
+func (g Game) CheckWin() bool {
+	_, ok := g.WinningLine()
+	return ok
+}
+
+// WinningLine returns the first full line summing to Target, if there is one
+func (g Game) WinningLine() ([]game.Position, bool) {
+	for _, line := range lines {
+		sum, full := 0, true
+		for _, p := range line {
+			if g.Cell(p) == Empty {
+				full = false
+				break
+			}
+			sum += int(g.Cell(p))
+		}
+		if full && sum == Target {
+			return line, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,160 @@
+package numerical
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// pos is shorthand for a board position
+func pos(row, col int) game.Position {
+	return game.Position{Row: row, Col: col}
+}
+
+// play makes each move in turn, failing the test on an illegal move
+func play(t *testing.T, moves ...Move) Game {
+	t.Helper()
+	g := NewGame()
+	for _, m := range moves {
+		next, err := g.MakeMove(m.Number, m.Position)
+		if err != nil {
+			t.Fatalf("MakeMove(%d, %v) returned error: %v", m.Number, m.Position, err)
+		}
+		g = next
+	}
+	return g
+}
+
+// TestOwns verifies Player 1 places the odd numbers and Player 2 the even
+func TestOwns(t *testing.T) {
+	for n := 1; n <= MaxNumber; n++ {
+		if Owns(game.Player1, n) != (n%2 == 1) || Owns(game.Player2, n) != (n%2 == 0) {
+			t.Errorf("Owns(_, %d) gives %d to the wrong player", n, n)
+		}
+	}
+}
+
+// TestPlayerName verifies players are named by the numbers they place
+func TestPlayerName(t *testing.T) {
+	tests := []struct {
+		player game.Player
+		want   string
+	}{
+		{game.Player1, "Player 1 (odd)"},
+		{game.Player2, "Player 2 (even)"},
+	}
+	for _, tt := range tests {
+		if got := PlayerName(tt.player); got != tt.want {
+			t.Errorf("PlayerName(%v) = %q, want %q", tt.player, got, tt.want)
+		}
+	}
+}
+
+// TestMakeMove verifies numbers are placed, alternate between players and are used up
+func TestMakeMove(t *testing.T) {
+	g := play(t, Move{Number: 5, Position: pos(1, 1)}, Move{Number: 4, Position: pos(0, 0)})
+
+	if g.Cell(pos(1, 1)) != 5 || g.Cell(pos(0, 0)) != 4 || g.MoveCount != 2 {
+		t.Errorf("Board = %v after %d moves, want 5 in the centre and 4 top-left", g.Board, g.MoveCount)
+	}
+	if g.CurrentPlayer != game.Player1 || g.State != game.InProgress {
+		t.Errorf("CurrentPlayer = %v, State = %v; want Player1 to move in progress", g.CurrentPlayer, g.State)
+	}
+	if got := g.Available(game.Player1); len(got) != 4 || got[0] != 1 || got[3] != 9 {
+		t.Errorf("Available(Player1) = %v, want [1 3 7 9]", got)
+	}
+	if got := g.Available(game.Player2); len(got) != 3 || got[0] != 2 {
+		t.Errorf("Available(Player2) = %v, want [2 6 8]", got)
+	}
+	want := Move{Player: game.Player2, Number: 4, Position: pos(0, 0), State: game.InProgress}
+	if len(g.History) != 2 || g.History[1] != want {
+		t.Errorf("History = %+v, want the second move %+v", g.History, want)
+	}
+}
+
+// TestMakeMoveErrors verifies illegal moves are rejected without changing the game
+func TestMakeMoveErrors(t *testing.T) {
+	g := play(t, Move{Number: 5, Position: pos(1, 1)})
+	won := play(t,
+		Move{Number: 1, Position: pos(0, 0)}, Move{Number: 8, Position: pos(1, 1)},
+		Move{Number: 3, Position: pos(0, 2)}, Move{Number: 6, Position: pos(2, 2)},
+	)
+
+	tests := []struct {
+		name    string
+		game    Game
+		number  int
+		p       game.Position
+		wantErr error
+	}{
+		{"Zero", g, 0, pos(0, 0), ErrInvalidNumber},
+		{"Ten", g, 10, pos(0, 0), ErrInvalidNumber},
+		{"Odd number for Player 2", g, 3, pos(0, 0), ErrWrongParity},
+		{"Number already used", play(t, Move{Number: 5, Position: pos(1, 1)}, Move{Number: 2, Position: pos(0, 0)}), 5, pos(2, 2), ErrNumberUsed},
+		{"Off the board", g, 2, pos(3, 0), game.ErrInvalidRange},
+		{"Occupied", g, 2, pos(1, 1), game.ErrCellOccupied},
+		{"Game over", won, 5, pos(1, 0), ErrGameOver},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next, err := tt.game.MakeMove(tt.number, tt.p)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("MakeMove(%d, %v) error = %v, want %v", tt.number, tt.p, err, tt.wantErr)
+			}
+			if next.MoveCount != tt.game.MoveCount || next.Board != tt.game.Board {
+				t.Error("MakeMove() changed the game after an illegal move")
+			}
+		})
+	}
+}
+
+// TestMakeMoveOutcome verifies a line summing to 15 wins for the mover and a
+// full board without one is a draw
+func TestMakeMoveOutcome(t *testing.T) {
+	tests := []struct {
+		name      string
+		moves     []Move
+		wantState game.GameState
+		wantLine  []game.Position
+	}{
+		{"Player 2 completes a diagonal of 1, 8 and 6", []Move{
+			{Number: 1, Position: pos(0, 0)}, {Number: 8, Position: pos(1, 1)},
+			{Number: 3, Position: pos(0, 2)}, {Number: 6, Position: pos(2, 2)},
+		}, game.Player2Won, []game.Position{pos(0, 0), pos(1, 1), pos(2, 2)}},
+		{"Player 1 completes a row of 2, 4 and 9", []Move{
+			{Number: 1, Position: pos(2, 2)}, {Number: 2, Position: pos(0, 0)},
+			{Number: 3, Position: pos(2, 1)}, {Number: 4, Position: pos(0, 1)},
+			{Number: 9, Position: pos(0, 2)},
+		}, game.Player1Won, []game.Position{pos(0, 0), pos(0, 1), pos(0, 2)}},
+		{"A full row summing to 6 does not win", []Move{
+			{Number: 1, Position: pos(0, 0)}, {Number: 2, Position: pos(0, 1)},
+			{Number: 3, Position: pos(0, 2)},
+		}, game.InProgress, nil},
+		{"Full board without a 15 is a draw", []Move{
+			{Number: 1, Position: pos(0, 0)}, {Number: 2, Position: pos(0, 1)},
+			{Number: 3, Position: pos(0, 2)}, {Number: 4, Position: pos(1, 0)},
+			{Number: 5, Position: pos(1, 1)}, {Number: 6, Position: pos(2, 0)},
+			{Number: 7, Position: pos(1, 2)}, {Number: 8, Position: pos(2, 2)},
+			{Number: 9, Position: pos(2, 1)},
+		}, game.Draw, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := play(t, tt.moves...)
+			if g.State != tt.wantState {
+				t.Errorf("State = %v, want %v", g.State, tt.wantState)
+			}
+			line, ok := g.WinningLine()
+			won := tt.wantState == game.Player1Won || tt.wantState == game.Player2Won
+			if ok != won {
+				t.Errorf("WinningLine() found = %v, want %v", ok, won)
+			}
+			if tt.wantLine != nil && (len(line) != len(tt.wantLine) || line[0] != tt.wantLine[0] || line[2] != tt.wantLine[2]) {
+				t.Errorf("WinningLine() = %v, want %v", line, tt.wantLine)
+			}
+		})
+	}
+}
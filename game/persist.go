@@ -0,0 +1,304 @@
+package game
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// SaveVersion is the schema version written by Save
+// Version 2 added win_length for boards other than 3x3, and version 3 added
+// variant for misere and wild games, and mark for moves placing the opponent's mark
+// Load refuses files newer than SaveVersion or older than MinSaveVersion
+const SaveVersion = 3
+
+// MinSaveVersion is the oldest schema version Load accepts
+const MinSaveVersion = 1
+
+// emptyCellChar marks an empty cell in saved board rows
+const emptyCellChar = '.'
+
+// Error types for loading saved games
+var (
+	ErrCorruptSave        = &GameError{"Saved game is corrupt or not a tic-tac-toe save file"}
+	ErrUnsupportedVersion = &GameError{"Saved game was written by an unsupported version"}
+	ErrInvalidMarkCount   = &GameError{"Invalid position. Mark counts are impossible with alternating turns"}
+	ErrInconsistentState  = &GameError{"Invalid position. Game state does not match the board"}
+	ErrInvalidHistory     = &GameError{"Invalid position. Move history does not lead to the board"}
+)
+
+// savedGame is the stable JSON schema for a saved game
+type savedGame struct {
+	Version       int         `json:"version"`
+	WinLength     int         `json:"win_length,omitempty"` // Defaults to DefaultWinLength
+	Variant       string      `json:"variant,omitempty"`    // "misere" or "wild", or omitted for standard rules
+	Board         []string    `json:"board"`                // One string per row, "X", "O" or "." per cell
+	CurrentPlayer string      `json:"current_player"`       // "X" or "O"
+	State         string      `json:"state"`                // See stateNames
+	MoveCount     int         `json:"move_count"`
+	History       []savedMove `json:"history"`
+}
+
+// savedMove is the stable JSON schema for a history entry
+type savedMove struct {
+	Player string `json:"player"`
+	Row    int    `json:"row"`
+	Col    int    `json:"col"`
+	Mark   string `json:"mark,omitempty"` // "X" or "O", or omitted for the player's own mark
+	State  string `json:"state"`
+}
+
+// stateNames maps each GameState to its saved name
+var stateNames = map[GameState]string{
+	InProgress: "in_progress",
+	Player1Won: "player1_won",
+	Player2Won: "player2_won",
+	Draw:       "draw",
+}
+
+// MarshalText encodes the state using its saved name, e.g. "player1_won"
+func (s GameState) MarshalText() ([]byte, error) {
+	name, ok := stateNames[s]
+	if !ok {
+		return nil, fmt.Errorf("unknown game state %d", int(s))
+	}
+	return []byte(name), nil
+}
+
+// UnmarshalText decodes a state from its saved name
+func (s *GameState) UnmarshalText(text []byte) error {
+	state, err := decodeState(string(text))
+	if err != nil {
+		return err
+	}
+	*s = state
+	return nil
+}
+
+// MarshalJSON encodes the game using the versioned save schema
+// Undone moves are not saved, so a loaded game cannot redo them
+func (g Game) MarshalJSON() ([]byte, error) {
+	saved := savedGame{
+		Version:       SaveVersion,
+		WinLength:     g.Config.normalized().WinLength,
+		Board:         make([]string, 0, g.Board.Height()),
+		CurrentPlayer: g.CurrentPlayer.GetMark().String(),
+		State:         stateNames[g.State],
+		MoveCount:     g.MoveCount,
+		History:       make([]savedMove, 0, len(g.History)),
+	}
+	if g.Config.Variant != Standard {
+		saved.Variant = g.Config.Variant.String()
+	}
+	for _, cells := range g.Board {
+		var line strings.Builder
+		for _, cell := range cells {
+			line.WriteByte(cellChar(cell))
+		}
+		saved.Board = append(saved.Board, line.String())
+	}
+	for _, m := range g.History {
+		move := savedMove{
+			Player: m.Player.GetMark().String(),
+			Row:    m.Row,
+			Col:    m.Col,
+			State:  stateNames[m.State],
+		}
+		if m.Mark != m.Player.GetMark() {
+			move.Mark = m.Mark.String()
+		}
+		saved.History = append(saved.History, move)
+	}
+	return json.Marshal(saved)
+}
+
+// UnmarshalJSON decodes a game written by MarshalJSON and validates it
+// The receiver is left unchanged if the data is rejected
+func (g *Game) UnmarshalJSON(data []byte) error {
+	var saved savedGame
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return fmt.Errorf("%w: %v", ErrCorruptSave, err)
+	}
+	if saved.Version < MinSaveVersion || saved.Version > SaveVersion {
+		return fmt.Errorf("%w: got version %d, want %d to %d",
+			ErrUnsupportedVersion, saved.Version, MinSaveVersion, SaveVersion)
+	}
+
+	decoded, err := saved.decode()
+	if err != nil {
+		return err
+	}
+	if err := ValidatePosition(decoded); err != nil {
+		return err
+	}
+	if err := validateHistory(decoded); err != nil {
+		return err
+	}
+
+	*g = decoded
+	return nil
+}
+
+// decode converts the saved schema into a Game without checking game rules
+func (s savedGame) decode() (Game, error) {
+	var g Game
+	var err error
+	if g.Board, err = decodeBoard(s.Board); err != nil {
+		return Game{}, err
+	}
+	g.Config = Config{Width: g.Board.Width(), Height: g.Board.Height(), WinLength: s.WinLength}
+	if s.WinLength == 0 {
+		g.Config.WinLength = DefaultWinLength
+	}
+	if s.Variant != "" {
+		if g.Config.Variant, err = ParseVariant(s.Variant); err != nil {
+			return Game{}, fmt.Errorf("%w: %v", ErrCorruptSave, err)
+		}
+	}
+	if err := g.Config.Validate(); err != nil {
+		return Game{}, fmt.Errorf("%w: %v", ErrCorruptSave, err)
+	}
+	if g.CurrentPlayer, err = decodePlayer(s.CurrentPlayer); err != nil {
+		return Game{}, err
+	}
+	if g.State, err = decodeState(s.State); err != nil {
+		return Game{}, err
+	}
+	g.MoveCount = s.MoveCount
+
+	for i, m := range s.History {
+		move := Move{Row: m.Row, Col: m.Col}
+		if move.Player, err = decodePlayer(m.Player); err != nil {
+			return Game{}, fmt.Errorf("history entry %d: %w", i+1, err)
+		}
+		if move.State, err = decodeState(m.State); err != nil {
+			return Game{}, fmt.Errorf("history entry %d: %w", i+1, err)
+		}
+		move.Mark = move.Player.GetMark()
+		if m.Mark != "" {
+			owner, err := decodePlayer(m.Mark)
+			if err != nil {
+				return Game{}, fmt.Errorf("history entry %d: %w", i+1, err)
+			}
+			move.Mark = owner.GetMark()
+		}
+		g.History = append(g.History, move)
+	}
+	return g, nil
+}
+
+// decodeBoard parses saved board rows, which must all have the same length
+func decodeBoard(rows []string) (Board, error) {
+	if len(rows) == 0 || len(rows) > MaxDimension {
+		return nil, fmt.Errorf("%w: board has %d rows", ErrCorruptSave, len(rows))
+	}
+	board := NewBoardSize(len(rows[0]), len(rows))
+	for row, line := range rows {
+		if len(line) != board.Width() {
+			return nil, fmt.Errorf("%w: board row %d is %q", ErrCorruptSave, row, line)
+		}
+		for col := 0; col < len(line); col++ {
+			cell, ok := parseCellChar(line[col])
+			if !ok {
+				return nil, fmt.Errorf("%w: unknown cell %q in row %d", ErrCorruptSave, line[col], row)
+			}
+			board[row][col] = cell
+		}
+	}
+	return board, nil
+}
+
+// decodePlayer parses a saved player mark
+func decodePlayer(mark string) (Player, error) {
+	switch mark {
+	case "X":
+		return Player1, nil
+	case "O":
+		return Player2, nil
+	default:
+		return Player1, fmt.Errorf("%w: unknown player %q", ErrCorruptSave, mark)
+	}
+}
+
+// decodeState parses a saved game state name
+func decodeState(name string) (GameState, error) {
+	for state, stateName := range stateNames {
+		if name == stateName {
+			return state, nil
+		}
+	}
+	return InProgress, fmt.Errorf("%w: unknown state %q", ErrCorruptSave, name)
+}
+
+// cellChar returns the saved character for a cell
+func cellChar(c Cell) byte {
+	if c == Empty {
+		return emptyCellChar
+	}
+	return c.String()[0]
+}
+
+// parseCellChar converts a saved character into a cell
+func parseCellChar(ch byte) (Cell, bool) {
+	switch ch {
+	case emptyCellChar:
+		return Empty, true
+	case 'X', 'x':
+		return X, true
+	case 'O', 'o':
+		return O, true
+	default:
+		return Empty, false
+	}
+}
+
+// Save writes the game to w as indented JSON
+func Save(w io.Writer, g Game) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(g)
+}
+
+// Load reads and validates a game written by Save
+func Load(r io.Reader) (Game, error) {
+	var g Game
+	if err := json.NewDecoder(r).Decode(&g); err != nil {
+		var gameErr *GameError
+		if errors.As(err, &gameErr) {
+			return Game{}, err
+		}
+		return Game{}, fmt.Errorf("%w: %v", ErrCorruptSave, err)
+	}
+	return g, nil
+}
+
+// SaveFile writes the game to the file at path, replacing any existing file
+func SaveFile(path string, g Game) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := Save(f, g); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// LoadFile reads and validates a game from the file at path
+func LoadFile(path string) (Game, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Game{}, err
+	}
+	defer f.Close()
+
+	g, err := Load(f)
+	if err != nil {
+		return Game{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return g, nil
+}
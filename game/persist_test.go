@@ -0,0 +1,271 @@
+package game
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestSaveLoadRoundTrip verifies a game survives Save and Load unchanged
+func TestSaveLoadRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		moves [][2]int
+	}{
+		{"New game", nil},
+		{"Game in progress", [][2]int{{1, 1}, {0, 0}, {2, 2}}},
+		{"Won game", [][2]int{{0, 0}, {1, 0}, {0, 1}, {1, 1}, {0, 2}}},
+		{"Drawn game", [][2]int{{0, 0}, {0, 1}, {0, 2}, {1, 1}, {1, 0}, {1, 2}, {2, 1}, {2, 0}, {2, 2}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := playMoves(t, tt.moves)
+
+			var buf bytes.Buffer
+			if err := Save(&buf, original); err != nil {
+				t.Fatalf("Save() returned error: %v", err)
+			}
+			loaded, err := Load(&buf)
+			if err != nil {
+				t.Fatalf("Load() returned error: %v", err)
+			}
+
+			if !loaded.Board.Equal(original.Board) || loaded.CurrentPlayer != original.CurrentPlayer ||
+				loaded.State != original.State || loaded.MoveCount != original.MoveCount {
+				t.Errorf("Load() = %+v, want %+v", loaded, original)
+			}
+			if len(loaded.History) != len(original.History) {
+				t.Fatalf("len(History) = %d, want %d", len(loaded.History), len(original.History))
+			}
+			for i := range original.History {
+				if loaded.History[i] != original.History[i] {
+					t.Errorf("History[%d] = %+v, want %+v", i, loaded.History[i], original.History[i])
+				}
+			}
+		})
+	}
+}
+
+// TestSaveSchema verifies the saved JSON uses the documented field names
+func TestSaveSchema(t *testing.T) {
+	g := playMoves(t, [][2]int{{1, 1}, {0, 2}})
+
+	data, err := json.Marshal(g)
+	if err != nil {
+		t.Fatalf("json.Marshal() returned error: %v", err)
+	}
+
+	want := `{"version":3,"win_length":3,"board":["..O",".X.","..."],"current_player":"X","state":"in_progress",` +
+		`"move_count":2,"history":[{"player":"X","row":1,"col":1,"state":"in_progress"},` +
+		`{"player":"O","row":0,"col":2,"state":"in_progress"}]}`
+	if string(data) != want {
+		t.Errorf("json.Marshal() =\n%s\nwant\n%s", data, want)
+	}
+}
+
+// TestLoadRejectsCorruptFiles verifies invalid saves are refused with typed errors
+func TestLoadRejectsCorruptFiles(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr error
+	}{
+		{
+			name:    "Not JSON",
+			data:    "row col",
+			wantErr: ErrCorruptSave,
+		},
+		{
+			name:    "Future version",
+			data:    `{"version":99,"board":["...","...","..."],"current_player":"X","state":"in_progress"}`,
+			wantErr: ErrUnsupportedVersion,
+		},
+		{
+			name:    "Unknown cell",
+			data:    `{"version":1,"board":["..Z","...","..."],"current_player":"X","state":"in_progress"}`,
+			wantErr: ErrCorruptSave,
+		},
+		{
+			name:    "Ragged board",
+			data:    `{"version":1,"board":["...",".."],"current_player":"X","state":"in_progress"}`,
+			wantErr: ErrCorruptSave,
+		},
+		{
+			name:    "Unknown state",
+			data:    `{"version":1,"board":["...","...","..."],"current_player":"X","state":"paused"}`,
+			wantErr: ErrCorruptSave,
+		},
+		{
+			name:    "Unknown variant",
+			data:    `{"version":3,"variant":"reverse","board":["...","...","..."],"current_player":"X","state":"in_progress"}`,
+			wantErr: ErrCorruptSave,
+		},
+		{
+			name:    "Win length longer than board",
+			data:    `{"version":2,"win_length":4,"board":["...","...","..."],"current_player":"X","state":"in_progress"}`,
+			wantErr: ErrCorruptSave,
+		},
+		{
+			name: "Too many X marks",
+			data: `{"version":1,"board":["XX.","...","..."],"current_player":"O","state":"in_progress",` +
+				`"move_count":2}`,
+			wantErr: ErrInvalidMarkCount,
+		},
+		{
+			name: "Win not recorded",
+			data: `{"version":1,"board":["XXX","OO.","..."],"current_player":"X","state":"in_progress",` +
+				`"move_count":5}`,
+			wantErr: ErrInvalidMarkCount,
+		},
+		{
+			name: "Win claimed on open board",
+			data: `{"version":1,"board":["X..","...","..."],"current_player":"X","state":"player1_won",` +
+				`"move_count":1}`,
+			wantErr: ErrInconsistentState,
+		},
+		{
+			name: "History disagrees with board",
+			data: `{"version":1,"board":["X..","...","..."],"current_player":"O","state":"in_progress",` +
+				`"move_count":1,"history":[{"player":"X","row":2,"col":2,"state":"in_progress"}]}`,
+			wantErr: ErrInvalidHistory,
+		},
+		{
+			name: "History move off the board",
+			data: `{"version":3,"board":["X..","...","..."],"current_player":"O","state":"in_progress",` +
+				`"move_count":1,"history":[{"player":"X","row":99,"col":0,"state":"in_progress"}]}`,
+			wantErr: ErrInvalidHistory,
+		},
+		{
+			name: "History move on the opponent's mark",
+			data: `{"version":3,"board":["XO.","...","..."],"current_player":"X","state":"in_progress",` +
+				`"move_count":2,"history":[{"player":"X","row":0,"col":1,"state":"in_progress"},` +
+				`{"player":"O","row":0,"col":0,"state":"in_progress"}]}`,
+			wantErr: ErrInvalidHistory,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(strings.NewReader(tt.data))
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Load() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestSaveFileLoadFile verifies games round-trip through the file system
+func TestSaveFileLoadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "game.json")
+	original := playMoves(t, [][2]int{{0, 0}, {1, 1}})
+
+	if err := SaveFile(path, original); err != nil {
+		t.Fatalf("SaveFile() returned error: %v", err)
+	}
+	loaded, err := LoadFile(path)
+	if err != nil {
+		t.Fatalf("LoadFile() returned error: %v", err)
+	}
+	if !loaded.Board.Equal(original.Board) || len(loaded.History) != 2 {
+		t.Errorf("LoadFile() = %+v, want %+v", loaded, original)
+	}
+
+	if _, err := LoadFile(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("LoadFile() on a missing file should return an error")
+	}
+}
+
+// TestLoadVersion1 verifies files written before win_length existed still load as 3x3 games
+func TestLoadVersion1(t *testing.T) {
+	data := `{"version":1,"board":["X..","...","..."],"current_player":"O","state":"in_progress","move_count":1}`
+
+	g, err := Load(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("Load() returned error: %v", err)
+	}
+	if !g.Config.IsStandard() {
+		t.Errorf("Load() Config = %v, want standard 3x3", g.Config)
+	}
+}
+
+// TestSaveLoadCustomConfig verifies board dimensions and win length round-trip
+func TestSaveLoadCustomConfig(t *testing.T) {
+	config := Config{Width: 5, Height: 4, WinLength: 4}
+	g, err := NewGameWithConfig(config)
+	if err != nil {
+		t.Fatalf("NewGameWithConfig() returned error: %v", err)
+	}
+	g, _ = g.MakeMove(3, 4)
+
+	var buf bytes.Buffer
+	if err := Save(&buf, g); err != nil {
+		t.Fatalf("Save() returned error: %v", err)
+	}
+	loaded, err := Load(&buf)
+	if err != nil {
+		t.Fatalf("Load() returned error: %v", err)
+	}
+	if loaded.Config != config || loaded.Board.GetCell(3, 4) != X {
+		t.Errorf("Load() = %v with %v, want %v with X at (3,4)", loaded.Config, loaded.Board, config)
+	}
+}
+
+// TestSaveLoadMisere verifies a finished misere game round-trips with its variant
+func TestSaveLoadMisere(t *testing.T) {
+	g, err := NewGameWithConfig(Config{Width: 3, Height: 3, WinLength: 3, Variant: Misere})
+	if err != nil {
+		t.Fatalf("NewGameWithConfig() returned error: %v", err)
+	}
+	for _, m := range [][2]int{{0, 0}, {1, 0}, {0, 1}, {1, 1}, {0, 2}} {
+		g, _ = g.MakeMove(m[0], m[1])
+	}
+
+	data, err := json.Marshal(g)
+	if err != nil {
+		t.Fatalf("json.Marshal() returned error: %v", err)
+	}
+	if !strings.Contains(string(data), `"variant":"misere"`) {
+		t.Errorf("json.Marshal() = %s, want a misere variant", data)
+	}
+
+	var loaded Game
+	if err := json.Unmarshal(data, &loaded); err != nil {
+		t.Fatalf("json.Unmarshal() returned error: %v", err)
+	}
+	if loaded.Config.Variant != Misere || loaded.State != Player2Won {
+		t.Errorf("Loaded variant %v with state %v, want misere won by O", loaded.Config.Variant, loaded.State)
+	}
+
+	// The same file without its variant no longer matches the board
+	standard := strings.Replace(string(data), `"variant":"misere",`, "", 1)
+	if err := json.Unmarshal([]byte(standard), &loaded); !errors.Is(err, ErrInconsistentState) {
+		t.Errorf("Loading without the variant error = %v, want ErrInconsistentState", err)
+	}
+}
+
+// TestGameStateText verifies states round-trip through their saved names
+func TestGameStateText(t *testing.T) {
+	for state, name := range stateNames {
+		text, err := state.MarshalText()
+		if err != nil || string(text) != name {
+			t.Errorf("MarshalText(%v) = %q, %v; want %q", state, text, err, name)
+		}
+
+		var got GameState
+		if err := got.UnmarshalText(text); err != nil || got != state {
+			t.Errorf("UnmarshalText(%q) = %v, %v; want %v", text, got, err, state)
+		}
+	}
+
+	var s GameState
+	if err := s.UnmarshalText([]byte("won")); !errors.Is(err, ErrCorruptSave) {
+		t.Errorf("UnmarshalText(\"won\") error = %v, want ErrCorruptSave", err)
+	}
+	if _, err := GameState(99).MarshalText(); err == nil {
+		t.Error("MarshalText(99) returned no error")
+	}
+}
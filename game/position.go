@@ -0,0 +1,148 @@
+package game
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// rowSeparator separates board rows in a position string
+const rowSeparator = "/"
+
+// ErrInvalidPosition indicates a position string that cannot be parsed
+var ErrInvalidPosition = &GameError{"Invalid position string. Expected rows of X, O and . separated by /, then x or o, e.g. \"X.O/.X./..O x\""}
+
+// String returns the board's rows separated by slashes, e.g. "X.O/.X./..O"
+func (b Board) String() string {
+	var s strings.Builder
+	for row, cells := range b {
+		if row > 0 {
+			s.WriteString(rowSeparator)
+		}
+		for _, cell := range cells {
+			s.WriteByte(cellChar(cell))
+		}
+	}
+	return s.String()
+}
+
+// ParseBoard builds a board from rows of X, O and . separated by slashes
+func ParseBoard(s string) (Board, error) {
+	rows := strings.Split(s, rowSeparator)
+	if len(rows) > MaxDimension || len(rows[0]) == 0 || len(rows[0]) > MaxDimension {
+		return nil, fmt.Errorf("%w: board must be between 1x1 and %dx%d", ErrInvalidPosition, MaxDimension, MaxDimension)
+	}
+
+	board := NewBoardSize(len(rows[0]), len(rows))
+	for row, line := range rows {
+		if len(line) != board.Width() {
+			return nil, fmt.Errorf("%w: row %d is %q but row 0 has %d cells", ErrInvalidPosition, row, line, board.Width())
+		}
+		for col := 0; col < len(line); col++ {
+			cell, ok := parseCellChar(line[col])
+			if !ok {
+				return nil, fmt.Errorf("%w: unknown cell %q in row %d", ErrInvalidPosition, line[col], row)
+			}
+			board[row][col] = cell
+		}
+	}
+	return board, nil
+}
+
+// String returns the game's position as a one-line string: the board, the
+// side to move next, the win length if it is not DefaultWinLength and the
+// variant if it is not Standard, e.g. "X.O/.X./..O x", "...../...../...../..... o 4"
+// or "XX./.O./... o misere"
+// In a finished game the side to move is the player who did not move last
+func (g Game) String() string {
+	next := g.CurrentPlayer
+	if g.State != InProgress {
+		next = next.Other()
+	}
+
+	s := g.Board.String() + " " + strings.ToLower(next.GetMark().String())
+	if k := g.Config.normalized().WinLength; k != DefaultWinLength {
+		s += " " + strconv.Itoa(k)
+	}
+	if g.Config.Variant != Standard {
+		s += " " + g.Config.Variant.String()
+	}
+	return s
+}
+
+// ParsePosition builds a game from a position string written by Game.String
+// The board size comes from the rows, the win length defaults to
+// DefaultWinLength and the variant to Standard. The game is built and checked
+// by FromBoard
+func ParsePosition(s string) (Game, error) {
+	fields := strings.Fields(s)
+	if len(fields) < 2 || len(fields) > 4 {
+		return Game{}, fmt.Errorf("%w: got %q", ErrInvalidPosition, s)
+	}
+
+	board, err := ParseBoard(fields[0])
+	if err != nil {
+		return Game{}, err
+	}
+
+	var next Player
+	switch fields[1] {
+	case "x", "X":
+		next = Player1
+	case "o", "O":
+		next = Player2
+	default:
+		return Game{}, fmt.Errorf("%w: side to move must be x or o, got %q", ErrInvalidPosition, fields[1])
+	}
+
+	config := Config{Width: board.Width(), Height: board.Height(), WinLength: DefaultWinLength}
+	rest := fields[2:]
+	if len(rest) > 0 {
+		if k, err := strconv.Atoi(rest[0]); err == nil {
+			config.WinLength = k
+			rest = rest[1:]
+		}
+	}
+	if len(rest) > 0 {
+		if config.Variant, err = ParseVariant(rest[0]); err != nil || len(rest) > 1 {
+			return Game{}, fmt.Errorf("%w: expected a win length and then a variant after the side to move, got %q",
+				ErrInvalidPosition, strings.Join(fields[2:], " "))
+		}
+	}
+	return FromBoard(config, board, next)
+}
+
+// FromBoard builds a game on board with config and next as the side to move
+// State is derived from the configured CheckWin, Outcome and CheckDraw, and the
+// position is checked with ValidatePosition. The game has no move history,
+// so earlier moves cannot be undone
+func FromBoard(config Config, board Board, next Player) (Game, error) {
+	config = config.normalized()
+	if err := config.Validate(); err != nil {
+		return Game{}, err
+	}
+
+	g := Game{Config: config, Board: board, CurrentPlayer: next, MoveCount: board.CountOccupied()}
+	switch xLine, oLine := config.CheckWin(board, X), config.CheckWin(board, O); {
+	case xLine && oLine:
+		return Game{}, fmt.Errorf("%w: both players have a winning line", ErrInconsistentState)
+	case (xLine || oLine) && config.Variant == Wild:
+		// The line counts for the last mover, whichever mark formed it
+		g.State = config.Outcome(next.Other())
+	case xLine:
+		g.State = config.Outcome(Player1)
+	case oLine:
+		g.State = config.Outcome(Player2)
+	case config.CheckDraw(board):
+		g.State = Draw
+	}
+	if g.State != InProgress {
+		// A finished game keeps the last mover as CurrentPlayer
+		g.CurrentPlayer = next.Other()
+	}
+
+	if err := ValidatePosition(g); err != nil {
+		return Game{}, err
+	}
+	return g, nil
+}
@@ -0,0 +1,157 @@
+package game
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestParsePosition verifies position strings produce the expected game
+func TestParsePosition(t *testing.T) {
+	tests := []struct {
+		name       string
+		position   string
+		wantConfig Config
+		wantPlayer Player
+		wantState  GameState
+		wantMoves  int
+	}{
+		{"Empty board", ".../.../... x", StandardConfig(), Player1, InProgress, 0},
+		{"Mid-game, O to move", "X.O/.X./... o", StandardConfig(), Player2, InProgress, 3},
+		{"Mid-game, X to move", "X.O/.X./..O x", StandardConfig(), Player1, InProgress, 4},
+		{"O moved first", "..O/.../... x", StandardConfig(), Player1, InProgress, 1},
+		{"Uppercase side", "X../.../... O", StandardConfig(), Player2, InProgress, 1},
+		{"X has won", "XXX/OO./... o", StandardConfig(), Player1, Player1Won, 5},
+		{"O has won", "OOO/XX./X.. x", StandardConfig(), Player2, Player2Won, 6},
+		{"Draw", "XOX/XOO/OXX o", StandardConfig(), Player1, Draw, 9},
+		{"Bigger board", "..../.XO./..../.... x 4", Config{Width: 4, Height: 4, WinLength: 4}, Player1, InProgress, 2},
+		{"Rectangular board", "X..../..... o 3", Config{Width: 5, Height: 2, WinLength: 3}, Player2, InProgress, 1},
+		{"Misere, X completed a line", "XXX/OO./... o misere", Config{Width: 3, Height: 3, WinLength: 3, Variant: Misere}, Player1, Player2Won, 5},
+		{"Misere with win length", "X.../..../..../.... o 4 misere", Config{Width: 4, Height: 4, WinLength: 4, Variant: Misere}, Player2, InProgress, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := ParsePosition(tt.position)
+			if err != nil {
+				t.Fatalf("ParsePosition(%q) returned error: %v", tt.position, err)
+			}
+			if g.Config != tt.wantConfig {
+				t.Errorf("Config = %v, want %v", g.Config, tt.wantConfig)
+			}
+			if g.CurrentPlayer != tt.wantPlayer {
+				t.Errorf("CurrentPlayer = %v, want %v", g.CurrentPlayer, tt.wantPlayer)
+			}
+			if g.State != tt.wantState {
+				t.Errorf("State = %v, want %v", g.State, tt.wantState)
+			}
+			if g.MoveCount != tt.wantMoves {
+				t.Errorf("MoveCount = %d, want %d", g.MoveCount, tt.wantMoves)
+			}
+		})
+	}
+}
+
+// TestParsePositionPlayable verifies play continues normally from a parsed position
+func TestParsePositionPlayable(t *testing.T) {
+	g, err := ParsePosition("XX./OO./... x")
+	if err != nil {
+		t.Fatalf("ParsePosition() returned error: %v", err)
+	}
+	g, err = g.MakeMove(0, 2)
+	if err != nil {
+		t.Fatalf("MakeMove() returned error: %v", err)
+	}
+	if g.State != Player1Won {
+		t.Errorf("State = %v, want Player1Won", g.State)
+	}
+	if _, err := g.Undo(); err != nil {
+		t.Errorf("Undo() of the move after the position returned error: %v", err)
+	}
+}
+
+// TestParsePositionErrors verifies malformed and impossible positions are rejected
+func TestParsePositionErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		position string
+		wantErr  error
+	}{
+		{"Missing side", "X.O/.X./..O", ErrInvalidPosition},
+		{"Extra field", "X.O/.X./..O x 3 3", ErrInvalidPosition},
+		{"Unknown side", "X.O/.X./..O z", ErrInvalidPosition},
+		{"Unknown cell", "X.Q/.X./..O x", ErrInvalidPosition},
+		{"Ragged rows", "X.O/.X/..O x", ErrInvalidPosition},
+		{"Empty board", " x", ErrInvalidPosition},
+		{"Win length not a number", ".../.../... x k", ErrInvalidPosition},
+		{"Win length too long", ".../.../... x 4", ErrInvalidConfig},
+		{"Unknown variant", ".../.../... x reverse", ErrInvalidPosition},
+		{"Variant before win length", ".../.../... x misere 3", ErrInvalidPosition},
+		{"Misere line not completed last", "XXX/OO./O.. x misere", ErrInconsistentState},
+		{"Too many X", "XX./.../... o", ErrInvalidMarkCount},
+		{"Wrong side to move", "X../.../... x", ErrInvalidMarkCount},
+		{"Both players won", "XXX/OOO/... x", ErrInconsistentState},
+		{"Winner did not move last", "XXX/OO./O.. x", ErrInconsistentState},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParsePosition(tt.position); !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParsePosition(%q) error = %v, want %v", tt.position, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestPositionString verifies String writes positions that ParsePosition reads back
+func TestPositionString(t *testing.T) {
+	tests := []struct {
+		name string
+		g    Game
+		want string
+	}{
+		{"New game", NewGame(), ".../.../... x"},
+		{"After moves", playMoves(t, [][2]int{{1, 1}, {0, 0}, {2, 2}}), "O../.X./..X o"},
+		{"Finished game", playMoves(t, [][2]int{{0, 0}, {1, 0}, {0, 1}, {1, 1}, {0, 2}}), "XXX/OO./... o"},
+	}
+	big, _ := NewGameWithConfig(Config{Width: 5, Height: 4, WinLength: 4})
+	tests = append(tests, struct {
+		name string
+		g    Game
+		want string
+	}{"Custom win length", big, "...../...../...../..... x 4"})
+	misere, _ := NewGameWithConfig(Config{Width: 3, Height: 3, WinLength: 3, Variant: Misere})
+	misere, _ = misere.MakeMove(1, 1)
+	tests = append(tests, struct {
+		name string
+		g    Game
+		want string
+	}{"Misere", misere, ".../.X./... o misere"})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g.String(); got != tt.want {
+				t.Fatalf("String() = %q, want %q", got, tt.want)
+			}
+			parsed, err := ParsePosition(tt.want)
+			if err != nil {
+				t.Fatalf("ParsePosition(%q) returned error: %v", tt.want, err)
+			}
+			if !parsed.Board.Equal(tt.g.Board) || parsed.CurrentPlayer != tt.g.CurrentPlayer ||
+				parsed.State != tt.g.State || parsed.Config.normalized() != tt.g.Config.normalized() {
+				t.Errorf("ParsePosition(String()) = %+v, want %+v", parsed, tt.g)
+			}
+		})
+	}
+}
+
+// TestBoardStringParseBoard verifies boards round-trip through their string form
+func TestBoardStringParseBoard(t *testing.T) {
+	board := NewBoard().SetCell(0, 0, X).SetCell(1, 2, O)
+	if got := board.String(); got != "X../..O/..." {
+		t.Errorf("String() = %q, want %q", got, "X../..O/...")
+	}
+	parsed, err := ParseBoard(board.String())
+	if err != nil || !parsed.Equal(board) {
+		t.Errorf("ParseBoard(%q) = %v, %v", board.String(), parsed, err)
+	}
+}
@@ -0,0 +1,54 @@
+package qubic
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/YOUR_USERNAME/tictactoe/validation"
+)
+
+// LayerError reports a layer outside the cube
+// It matches validation.ErrInvalidRange with errors.Is
+type LayerError struct {
+	Layer int // Layer that was entered
+}
+
+func (e *LayerError) Error() string {
+	return fmt.Sprintf("Invalid position. Layer must be between 0 and %d, got %d", Size-1, e.Layer)
+}
+
+// Is reports whether target is validation.ErrInvalidRange
+func (e *LayerError) Is(target error) bool {
+	return target == validation.ErrInvalidRange
+}
+
+// ParseMove parses a move written as three numbers: layer, row and column, e.g. "1 2 3"
+// Every number must be between 0 and 3: a bad layer is reported as a
+// *LayerError and a bad row or column as a *validation.RangeError
+func ParseMove(input string) (Position, error) {
+	fields := strings.Fields(input)
+	switch {
+	case len(fields) < 3:
+		return Position{}, fmt.Errorf("%w: enter layer, row and column, e.g. '1 2 3'", validation.ErrIncompleteInput)
+	case len(fields) > 3:
+		return Position{}, fmt.Errorf("%w: expected 3 numbers, got %d", validation.ErrInvalidFormat, len(fields))
+	}
+
+	var numbers [3]int
+	for i, field := range fields {
+		n, err := validation.ValidateNumeric(field)
+		if err != nil {
+			return Position{}, err
+		}
+		numbers[i] = n
+	}
+
+	p := Position{Layer: numbers[0], Row: numbers[1], Col: numbers[2]}
+	if p.Layer < 0 || p.Layer >= Size {
+		return Position{}, &LayerError{Layer: p.Layer}
+	}
+	if err := validation.ValidateRangeSize(p.Row, p.Col, Size, Size); err != nil {
+		return Position{}, err
+	}
+	return p, nil
+}
@@ -0,0 +1,52 @@
+package qubic
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/YOUR_USERNAME/tictactoe/validation"
+)
+
+// TestParseMoveRangeErrors verifies a bad layer and a bad row or column are
+// told apart, so each can be explained to the player
+func TestParseMoveRangeErrors(t *testing.T) {
+	var layerErr *LayerError
+	if _, err := ParseMove("4 0 0"); !errors.As(err, &layerErr) || layerErr.Layer != 4 {
+		t.Errorf("ParseMove(\"4 0 0\") error = %v, want *LayerError for layer 4", err)
+	}
+	var rangeErr *validation.RangeError
+	if _, err := ParseMove("0 4 0"); !errors.As(err, &rangeErr) || rangeErr.Width != Size || rangeErr.Height != Size {
+		t.Errorf("ParseMove(\"0 4 0\") error = %v, want *validation.RangeError for a %dx%d layer", err, Size, Size)
+	}
+}
+
+// TestParseMove verifies three-coordinate move input
+func TestParseMove(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Position
+		wantErr error
+	}{
+		{"Layer, row and column", "1 2 3", Position{Layer: 1, Row: 2, Col: 3}, nil},
+		{"Extra spaces", "  0 0   0 ", Position{}, nil},
+		{"Empty", "", Position{}, validation.ErrIncompleteInput},
+		{"Two numbers", "1 1", Position{}, validation.ErrIncompleteInput},
+		{"Too many numbers", "1 1 1 1", Position{}, validation.ErrInvalidFormat},
+		{"Not a number", "1 x 1", Position{}, validation.ErrInvalidFormat},
+		{"Layer out of range", "4 0 0", Position{}, validation.ErrInvalidRange},
+		{"Negative column", "0 0 -1", Position{}, validation.ErrInvalidRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMove(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseMove(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseMove(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
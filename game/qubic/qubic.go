@@ -0,0 +1,181 @@
+// Package qubic implements Qubic, tic-tac-toe on a 4x4x4 cube
+//
+// The cube is four stacked 4x4 layers. A player wins by filling any of the
+// 76 straight lines of four cells: 48 rows, columns and pillars running
+// parallel to an edge, 24 diagonals lying in a plane through the cube and
+// the 4 space diagonals joining opposite corners
+package qubic
+
+import (
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// Size is the number of cells along each edge of the cube, and so the length of a winning line
+const Size = 4
+
+// Cells is the number of cells in the cube
+const Cells = Size * Size * Size
+
+// ErrGameOver indicates a move after the game has finished
+var ErrGameOver = &game.GameError{Message: "The game is over. No more moves can be played"}
+
+// Position addresses a cell of the cube
+type Position struct {
+	Layer int // Layer from 0 (top) to 3 (bottom)
+	Row   int // Row within the layer
+	Col   int // Column within the layer
+}
+
+// InBounds returns true if p addresses a cell of the cube
+func (p Position) InBounds() bool {
+	return p.Layer >= 0 && p.Layer < Size && p.Row >= 0 && p.Row < Size && p.Col >= 0 && p.Col < Size
+}
+
+// Line is a winning line of four cells, in order along the line
+type Line [Size]Position
+
+// lines holds every winning line, computed once
+var lines = buildLines()
+
+// Lines returns all 76 winning lines of the cube
+// The returned slice is shared and must not be modified
+func Lines() []Line {
+	return lines
+}
+
+// buildLines walks every direction through the cube from every cell and keeps
+// the lines that start on a face and fit inside the cube
+// Only one of each pair of opposite directions is used so each line is found once
+func buildLines() []Line {
+	var result []Line
+	for dl := -1; dl <= 1; dl++ {
+		for dr := -1; dr <= 1; dr++ {
+			for dc := -1; dc <= 1; dc++ {
+				if !forward(dl, dr, dc) {
+					continue
+				}
+				for layer := range Size {
+					for row := range Size {
+						for col := range Size {
+							end := Position{Layer: layer + dl*(Size-1), Row: row + dr*(Size-1), Col: col + dc*(Size-1)}
+							before := Position{Layer: layer - dl, Row: row - dr, Col: col - dc}
+							if !end.InBounds() || before.InBounds() {
+								continue
+							}
+							var line Line
+							for i := range Size {
+								line[i] = Position{Layer: layer + dl*i, Row: row + dr*i, Col: col + dc*i}
+							}
+							result = append(result, line)
+						}
+					}
+				}
+			}
+		}
+	}
+	return result
+}
+
+// forward returns true if the direction is non-zero and its first non-zero
+// component is positive, picking one of each pair of opposite directions
+func forward(dl, dr, dc int) bool {
+	switch {
+	case dl != 0:
+		return dl > 0
+	case dr != 0:
+		return dr > 0
+	default:
+		return dc > 0
+	}
+}
+
+// Move records a single Qubic move and the game state it produced
+type Move struct {
+	Player   game.Player    // Player who made the move
+	Position                // Cell played
+	State    game.GameState // Game state after the move
+}
+
+// Game represents a Qubic game
+// Like game.Game it is an immutable value: MakeMove returns a new Game
+type Game struct {
+	Layers        [Size]game.Board // The cube as four 4x4 layers, top first
+	CurrentPlayer game.Player      // Whose turn it is
+	State         game.GameState   // Current game status
+	MoveCount     int              // Number of moves made (0 to Cells)
+	History       []Move           // Moves played so far, oldest first
+}
+
+// NewGame creates a new Qubic game with Player 1 (X) to move
+func NewGame() Game {
+	g := Game{CurrentPlayer: game.Player1, State: game.InProgress}
+	for layer := range Size {
+		g.Layers[layer] = game.NewBoardSize(Size, Size)
+	}
+	return g
+}
+
+// Cell returns the contents of the cell at p
+func (g Game) Cell(p Position) game.Cell {
+	return g.Layers[p.Layer].GetCell(p.Row, p.Col)
+}
+
+// MakeMove places the current player's mark at p and returns the new game state
+// Returns ErrGameOver once the game is finished, game.ErrInvalidRange for a
+// position outside the cube and game.ErrCellOccupied for a taken cell
+func (g Game) MakeMove(p Position) (Game, error) {
+	switch {
+	case g.State != game.InProgress:
+		return g, ErrGameOver
+	case !p.InBounds():
+		return g, game.ErrInvalidRange
+	case g.Cell(p) != game.Empty:
+		return g, game.ErrCellOccupied
+	}
+
+	// Layers is an array, and SetCell copies the layer it changes
+	newGame := g
+	mark := g.CurrentPlayer.GetMark()
+	newGame.Layers[p.Layer] = g.Layers[p.Layer].SetCell(p.Row, p.Col, mark)
+	newGame.MoveCount++
+
+	switch {
+	case newGame.CheckWin(mark):
+		if g.CurrentPlayer == game.Player1 {
+			newGame.State = game.Player1Won
+		} else {
+			newGame.State = game.Player2Won
+		}
+	case newGame.MoveCount == Cells:
+		newGame.State = game.Draw
+	default:
+		newGame.CurrentPlayer = g.CurrentPlayer.Other()
+	}
+
+	move := Move{Player: g.CurrentPlayer, Position: p, State: newGame.State}
+	newGame.History = append(g.History[:len(g.History):len(g.History)], move)
+	return newGame, nil
+}
+
+// CheckWin returns true if mark fills any winning line
+func (g Game) CheckWin(mark game.Cell) bool {
+	_, ok := g.WinningLine(mark)
+	return ok
+}
+
+// WinningLine returns the first line filled by mark, if there is one
+func (g Game) WinningLine(mark game.Cell) (Line, bool) {
+	for _, line := range lines {
+		complete := true
+		for _, p := range line {
+			if g.Cell(p) != mark {
+				complete = false
+				break
+			}
+		}
+		if complete {
+			return line, true
+		}
+	}
+	return Line{}, false
+}
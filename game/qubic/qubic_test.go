@@ -0,0 +1,216 @@
+package qubic
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// drawnCube is a full cube, one layer per string, in which neither player has a line
+var drawnCube = [Size]string{
+	"OOOX/XXOO/XXOX/XXXO",
+	"OXOO/OXOX/OOXO/XOOX",
+	"XXXO/OOOX/XXOX/OXOO",
+	"XXXO/XOXX/OXOO/XOXO",
+}
+
+// mustMove makes a move or fails the test
+func mustMove(t *testing.T, g Game, p Position) Game {
+	t.Helper()
+	next, err := g.MakeMove(p)
+	if err != nil {
+		t.Fatalf("MakeMove(%v) returned error: %v", p, err)
+	}
+	return next
+}
+
+// TestLines verifies there are 76 distinct straight lines of four cells
+func TestLines(t *testing.T) {
+	if len(Lines()) != 76 {
+		t.Fatalf("len(Lines()) = %d, want 76", len(Lines()))
+	}
+
+	seen := make(map[[2]Position]bool)
+	through := make(map[Position]int)
+	kinds := make(map[int]int) // Number of lines by how many axes they move along
+	for _, line := range Lines() {
+		step := Position{Layer: line[1].Layer - line[0].Layer, Row: line[1].Row - line[0].Row, Col: line[1].Col - line[0].Col}
+		for i, p := range line {
+			want := Position{Layer: line[0].Layer + i*step.Layer, Row: line[0].Row + i*step.Row, Col: line[0].Col + i*step.Col}
+			if p != want || !p.InBounds() {
+				t.Fatalf("Line %v is not a straight line inside the cube", line)
+			}
+			through[p]++
+		}
+
+		// A line is the same whichever end it is read from
+		key := [2]Position{line[0], line[Size-1]}
+		if line[Size-1].Layer < line[0].Layer || (line[Size-1].Layer == line[0].Layer && line[Size-1].Row < line[0].Row) {
+			key = [2]Position{line[Size-1], line[0]}
+		}
+		if seen[key] {
+			t.Errorf("Line %v appears twice", line)
+		}
+		seen[key] = true
+
+		axes := 0
+		for _, d := range []int{step.Layer, step.Row, step.Col} {
+			if d != 0 {
+				axes++
+			}
+		}
+		kinds[axes]++
+	}
+
+	if kinds[1] != 48 || kinds[2] != 24 || kinds[3] != 4 {
+		t.Errorf("Lines by axes = %v, want 48 rows, columns and pillars, 24 plane diagonals and 4 space diagonals", kinds)
+	}
+
+	// Corners and the eight central cells lie on seven lines, every other cell on four
+	for p, n := range through {
+		want := 4
+		if isEdge(p.Layer) == isEdge(p.Row) && isEdge(p.Row) == isEdge(p.Col) {
+			want = 7
+		}
+		if n != want {
+			t.Errorf("Cell %v lies on %d lines, want %d", p, n, want)
+		}
+	}
+	if len(through) != Cells {
+		t.Errorf("Lines cover %d cells, want %d", len(through), Cells)
+	}
+}
+
+// isEdge returns true for a coordinate on the outside of the cube
+func isEdge(n int) bool {
+	return n == 0 || n == Size-1
+}
+
+// TestMakeMoveWins verifies each kind of line wins for the player who completes it
+func TestMakeMoveWins(t *testing.T) {
+	tests := []struct {
+		name string
+		line Line
+	}{
+		{"Row", Line{{2, 1, 0}, {2, 1, 1}, {2, 1, 2}, {2, 1, 3}}},
+		{"Column", Line{{0, 0, 3}, {0, 1, 3}, {0, 2, 3}, {0, 3, 3}}},
+		{"Pillar", Line{{0, 2, 1}, {1, 2, 1}, {2, 2, 1}, {3, 2, 1}}},
+		{"Layer diagonal", Line{{3, 0, 3}, {3, 1, 2}, {3, 2, 1}, {3, 3, 0}}},
+		{"Vertical diagonal", Line{{0, 3, 0}, {1, 2, 0}, {2, 1, 0}, {3, 0, 0}}},
+		{"Space diagonal", Line{{0, 0, 3}, {1, 1, 2}, {2, 2, 1}, {3, 3, 0}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// X plays the line while O answers in cells off it
+			g := NewGame()
+			for i, p := range tt.line {
+				g = mustMove(t, g, p)
+				if i == Size-1 {
+					break
+				}
+				if g.State != game.InProgress {
+					t.Fatalf("State = %v after %d moves of the line, want in progress", g.State, i+1)
+				}
+				for _, o := range []Position{{1, 0, 1}, {1, 3, 3}, {2, 0, 3}, {0, 1, 1}, {3, 1, 3}} {
+					if !onLine(o, tt.line) && g.Cell(o) == game.Empty {
+						g = mustMove(t, g, o)
+						break
+					}
+				}
+			}
+
+			if g.State != game.Player1Won || g.CurrentPlayer != game.Player1 {
+				t.Errorf("State = %v, player %v; want X won", g.State, g.CurrentPlayer)
+			}
+			if line, ok := g.WinningLine(game.X); !ok || line != tt.line && line != reverse(tt.line) {
+				t.Errorf("WinningLine(X) = %v, %v; want %v", line, ok, tt.line)
+			}
+			if _, err := g.MakeMove(Position{1, 0, 0}); !errors.Is(err, ErrGameOver) {
+				t.Errorf("MakeMove() after the game error = %v, want ErrGameOver", err)
+			}
+		})
+	}
+}
+
+// onLine returns true if p is one of the cells of line
+func onLine(p Position, line Line) bool {
+	for _, q := range line {
+		if p == q {
+			return true
+		}
+	}
+	return false
+}
+
+// reverse returns line read from the other end
+func reverse(line Line) Line {
+	var r Line
+	for i, p := range line {
+		r[Size-1-i] = p
+	}
+	return r
+}
+
+// TestMakeMoveDraw verifies filling the cube without a line is a draw
+func TestMakeMoveDraw(t *testing.T) {
+	g := NewGame()
+	for layer, s := range drawnCube {
+		board, err := game.ParseBoard(s)
+		if err != nil {
+			t.Fatalf("ParseBoard(%q) returned error: %v", s, err)
+		}
+		g.Layers[layer] = board
+	}
+	last := Position{Layer: 0, Row: 0, Col: 3}
+	g.Layers[0] = g.Layers[0].SetCell(last.Row, last.Col, game.Empty)
+	g.MoveCount = Cells - 1
+
+	g = mustMove(t, g, last)
+	if g.State != game.Draw {
+		t.Errorf("State = %v, want draw", g.State)
+	}
+}
+
+// TestMakeMoveErrors verifies invalid moves are rejected without changing the game
+func TestMakeMoveErrors(t *testing.T) {
+	g := mustMove(t, NewGame(), Position{1, 1, 1})
+
+	tests := []struct {
+		name    string
+		p       Position
+		wantErr error
+	}{
+		{"Layer out of range", Position{4, 0, 0}, game.ErrInvalidRange},
+		{"Row out of range", Position{0, -1, 0}, game.ErrInvalidRange},
+		{"Column out of range", Position{0, 0, 4}, game.ErrInvalidRange},
+		{"Occupied cell", Position{1, 1, 1}, game.ErrCellOccupied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next, err := g.MakeMove(tt.p)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("MakeMove(%v) error = %v, want %v", tt.p, err, tt.wantErr)
+			}
+			if next.MoveCount != g.MoveCount || next.CurrentPlayer != game.Player2 {
+				t.Error("Rejected move changed the game")
+			}
+		})
+	}
+}
+
+// TestMakeMoveIsImmutable verifies MakeMove leaves the original game untouched
+func TestMakeMoveIsImmutable(t *testing.T) {
+	g := NewGame()
+	next := mustMove(t, g, Position{3, 2, 1})
+
+	if g.Cell(Position{3, 2, 1}) != game.Empty || g.MoveCount != 0 || len(g.History) != 0 {
+		t.Error("MakeMove() modified the original game")
+	}
+	want := Move{Player: game.Player1, Position: Position{3, 2, 1}, State: game.InProgress}
+	if len(next.History) != 1 || next.History[0] != want {
+		t.Errorf("History = %+v, want [%+v]", next.History, want)
+	}
+}
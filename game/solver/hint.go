@@ -0,0 +1,111 @@
+package solver
+
+import "github.com/YOUR_USERNAME/tictactoe/game"
+
+// Reason explains why a hinted move is best
+type Reason int
+
+const (
+	// WinNow means the move completes a line and wins immediately
+	WinNow Reason = iota
+	// Block means the move occupies the cell where the opponent would complete a line
+	Block
+	// Fork means the move creates two or more immediate threats, so the
+	// opponent cannot block them all
+	Fork
+	// ForcedWin means the move keeps a win that needs several more moves
+	ForcedWin
+	// ForcedDraw means neither side can force a win and the move keeps the draw
+	ForcedDraw
+	// BestDefence means every move loses and this one holds out longest
+	BestDefence
+)
+
+// String returns a short name for the reason, e.g. "block"
+func (r Reason) String() string {
+	switch r {
+	case WinNow:
+		return "win"
+	case Block:
+		return "block"
+	case Fork:
+		return "fork"
+	case ForcedWin:
+		return "forced win"
+	case ForcedDraw:
+		return "forced draw"
+	case BestDefence:
+		return "best defence"
+	default:
+		return "unknown"
+	}
+}
+
+// Hint is a suggested move for the side to move and why it is suggested
+type Hint struct {
+	MoveResult        // The suggested move and its value for the mover
+	Reason     Reason // Why the move is suggested
+}
+
+// Hint suggests the best move in g for g.CurrentPlayer
+// Among the moves with the best value and distance it prefers, in order, a
+// move that wins now, blocks an immediate threat or creates a fork, then the
+// first in row-major order. Under misere rules ties always go to the first
+// in row-major order
+// Returns ErrTooLarge if the board has more than MaxEmptyCells empty cells,
+// ErrUnsupportedVariant for a wild game, and false if the game is over
+func (s *Solver) Hint(g game.Game) (Hint, bool, error) {
+	moves, err := s.Analyze(g)
+	if err != nil || len(moves) == 0 {
+		return Hint{}, false, err
+	}
+
+	best := Hint{MoveResult: moves[0], Reason: explain(g, moves[0])}
+	for _, m := range moves[1:] {
+		if m.Value != best.Value || m.Distance != best.Distance {
+			break
+		}
+		if reason := explain(g, m); reason < best.Reason {
+			best = Hint{MoveResult: m, Reason: reason}
+		}
+	}
+	return best, true, nil
+}
+
+// explain returns the reason m is a good move in g
+// Completing a line loses under misere rules, so wins, blocks and forks
+// only explain moves under standard rules
+func explain(g game.Game, m MoveResult) Reason {
+	mark := g.CurrentPlayer.GetMark()
+	opponent := g.CurrentPlayer.Other().GetMark()
+	after := g.Board.SetCell(m.Row, m.Col, mark)
+	standard := g.Config.Variant == game.Standard
+
+	switch {
+	case standard && g.Config.CheckWin(after, mark):
+		return WinNow
+	case standard && g.Config.CheckWin(g.Board.SetCell(m.Row, m.Col, opponent), opponent):
+		return Block
+	case standard && len(threats(g.Config, after, mark)) >= 2:
+		return Fork
+	case m.Value == Win:
+		return ForcedWin
+	case m.Value == Draw:
+		return ForcedDraw
+	default:
+		return BestDefence
+	}
+}
+
+// threats returns every empty cell where mark would complete a line on board
+func threats(config game.Config, board game.Board, mark game.Cell) []game.Position {
+	var cells []game.Position
+	for row := range board {
+		for col := range board[row] {
+			if board.IsCellEmpty(row, col) && config.CheckWin(board.SetCell(row, col, mark), mark) {
+				cells = append(cells, game.Position{Row: row, Col: col})
+			}
+		}
+	}
+	return cells
+}
@@ -0,0 +1,69 @@
+package solver
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// TestHint verifies the suggested move and its explanation
+func TestHint(t *testing.T) {
+	tests := []struct {
+		name       string
+		position   string
+		wantMove   game.Position
+		wantReason Reason
+		wantValue  Value
+	}{
+		{"Win now", "XX./OO./... x", game.Position{Row: 0, Col: 2}, WinNow, Win},
+		{"Win beats block", "XX./OO./... o", game.Position{Row: 1, Col: 2}, WinNow, Win},
+		{"Block", "XX./.O./... o", game.Position{Row: 0, Col: 2}, Block, Draw},
+		{"Fork", "XO./X../O.. x", game.Position{Row: 1, Col: 1}, Fork, Win},
+		{"Forced win", "XO./.../... x", game.Position{Row: 1, Col: 0}, ForcedWin, Win},
+		{"Forced draw", ".../.../... x", game.Position{Row: 0, Col: 0}, ForcedDraw, Draw},
+		{"Block in a lost position", "X.X/.O./O.X o", game.Position{Row: 0, Col: 1}, Block, Loss},
+		{"Best defence", "XOO/..X/.X. o", game.Position{Row: 1, Col: 0}, BestDefence, Loss},
+		{"Misere avoids the line", "XX./OO./... x misere", game.Position{Row: 2, Col: 0}, ForcedDraw, Draw},
+		{"Misere has no blocks", "XO./XX./O.. o misere", game.Position{Row: 0, Col: 2}, ForcedWin, Win},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := game.ParsePosition(tt.position)
+			if err != nil {
+				t.Fatalf("ParsePosition(%q) returned error: %v", tt.position, err)
+			}
+			h, ok, err := New().Hint(g)
+			if err != nil || !ok {
+				t.Fatalf("Hint() = %v, %v, want a hint", ok, err)
+			}
+			if h.Position != tt.wantMove || h.Reason != tt.wantReason || h.Value != tt.wantValue {
+				t.Errorf("Hint() = %v %v (%v), want %v %v (%v)",
+					h.Position, h.Reason, h.Value, tt.wantMove, tt.wantReason, tt.wantValue)
+			}
+		})
+	}
+}
+
+// TestHintFinishedOrTooLarge verifies no hint is given when the game is over or too big to solve
+func TestHintFinishedOrTooLarge(t *testing.T) {
+	finished, _ := game.ParsePosition("XXX/OO./... o")
+	if _, ok, err := New().Hint(finished); ok || err != nil {
+		t.Errorf("Hint(finished) = %v, %v, want no hint and no error", ok, err)
+	}
+
+	big, _ := game.NewGameWithConfig(game.Config{Width: 4, Height: 4, WinLength: 3})
+	if _, ok, err := New().Hint(big); ok || !errors.Is(err, ErrTooLarge) {
+		t.Errorf("Hint(4x4) = %v, %v, want ErrTooLarge", ok, err)
+	}
+}
+
+// TestReasonString verifies reasons have readable names
+func TestReasonString(t *testing.T) {
+	for reason, want := range map[Reason]string{WinNow: "win", Fork: "fork", BestDefence: "best defence", Reason(99): "unknown"} {
+		if got := reason.String(); got != want {
+			t.Errorf("Reason(%d).String() = %q, want %q", int(reason), got, want)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package solver
+
+import (
+	"fmt"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// Quality grades a move by how it changed the game-theoretic value for the mover
+type Quality int
+
+const (
+	// Optimal means the move kept the value of the position
+	Optimal Quality = iota
+	// Inaccuracy means the move turned a won position into a draw
+	Inaccuracy
+	// Blunder means the move turned a won or drawn position into a loss
+	Blunder
+)
+
+// String returns "optimal", "inaccuracy" or "blunder"
+func (q Quality) String() string {
+	switch q {
+	case Optimal:
+		return "optimal"
+	case Inaccuracy:
+		return "inaccuracy"
+	case Blunder:
+		return "blunder"
+	default:
+		return "unknown"
+	}
+}
+
+// Annotation grades one move of a game
+type Annotation struct {
+	game.Move
+	Before  Value           // Value for the mover before the move
+	After   Value           // Value for the mover after the move
+	Best    []game.Position // Every optimal move in the position before
+	Quality Quality
+}
+
+// Annotate grades each of moves played in order from start
+// Each move must be made by the side to move and must be legal
+// Returns ErrTooLarge if a position before a move has more than
+// MaxEmptyCells empty cells
+func (s *Solver) Annotate(start game.Game, moves []game.Move) ([]Annotation, error) {
+	g := start
+	annotations := make([]Annotation, 0, len(moves))
+	for i, m := range moves {
+		if m.Player != g.CurrentPlayer || g.State != game.InProgress {
+			return nil, fmt.Errorf("%w: move %d is out of turn", game.ErrInvalidHistory, i+1)
+		}
+		before, err := s.Solve(g)
+		if err != nil {
+			return nil, err
+		}
+		next, err := g.MakeMove(m.Row, m.Col)
+		if err != nil {
+			return nil, fmt.Errorf("%w: move %d: %v", game.ErrInvalidHistory, i+1, err)
+		}
+		after, err := s.Solve(next)
+		if err != nil {
+			return nil, err
+		}
+
+		a := Annotation{Move: m, Before: before.Value, After: -after.Value, Best: before.Moves}
+		a.Move.State = next.State
+		switch {
+		case a.After == a.Before:
+			a.Quality = Optimal
+		case a.After == Draw:
+			a.Quality = Inaccuracy
+		default:
+			a.Quality = Blunder
+		}
+		annotations = append(annotations, a)
+		g = next
+	}
+	return annotations, nil
+}
+
+// Review grades every move in g.History, starting from the position before
+// the first recorded move
+func (s *Solver) Review(g game.Game) ([]Annotation, error) {
+	start := g
+	for start.CanUndo() {
+		start, _ = start.Undo()
+	}
+	start.Undone = nil
+	return s.Annotate(start, g.History)
+}
@@ -0,0 +1,122 @@
+package solver
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// playGame plays moves from a new game or fails the test
+func playGame(t *testing.T, moves [][2]int) game.Game {
+	t.Helper()
+	g := game.NewGame()
+	for i, m := range moves {
+		var err error
+		if g, err = g.MakeMove(m[0], m[1]); err != nil {
+			t.Fatalf("Move %d at (%d,%d) failed: %v", i+1, m[0], m[1], err)
+		}
+	}
+	return g
+}
+
+// TestReview verifies each move is graded by the value it keeps or throws away
+func TestReview(t *testing.T) {
+	// X center, O answers on an edge and is lost, X corner, O blocks,
+	// X forks, O blocks one threat and X completes the other
+	g := playGame(t, [][2]int{{1, 1}, {0, 1}, {0, 0}, {2, 2}, {2, 0}, {0, 2}, {1, 0}})
+
+	annotations, err := New().Review(g)
+	if err != nil {
+		t.Fatalf("Review() returned error: %v", err)
+	}
+	want := []struct {
+		before, after Value
+		quality       Quality
+	}{
+		{Draw, Draw, Optimal},
+		{Draw, Loss, Blunder},
+		{Win, Win, Optimal},
+		{Loss, Loss, Optimal},
+		{Win, Win, Optimal},
+		{Loss, Loss, Optimal},
+		{Win, Win, Optimal},
+	}
+	if len(annotations) != len(want) {
+		t.Fatalf("Review() returned %d annotations, want %d", len(annotations), len(want))
+	}
+	for i, a := range annotations {
+		if a.Before != want[i].before || a.After != want[i].after || a.Quality != want[i].quality {
+			t.Errorf("Move %d (%d,%d) = %v -> %v %v, want %v -> %v %v", i+1, a.Row, a.Col,
+				a.Before, a.After, a.Quality, want[i].before, want[i].after, want[i].quality)
+		}
+	}
+	if last := annotations[len(annotations)-1]; last.State != game.Player1Won {
+		t.Errorf("Last move state = %v, want Player1Won", last.State)
+	}
+}
+
+// TestAnnotateQualities verifies inaccuracies and blunders from set positions
+func TestAnnotateQualities(t *testing.T) {
+	tests := []struct {
+		name     string
+		position string
+		move     [2]int
+		want     Quality
+		wantBest game.Position // First of the optimal moves
+	}{
+		{"Takes the win", "XX./OO./... x", [2]int{0, 2}, Optimal, game.Position{Row: 0, Col: 2}},
+		{"Misses the win, still draws", "XOX/OX./..O x", [2]int{1, 2}, Inaccuracy, game.Position{Row: 2, Col: 0}},
+		{"Misses the block", "XX./.O./... o", [2]int{2, 2}, Blunder, game.Position{Row: 0, Col: 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, err := game.ParsePosition(tt.position)
+			if err != nil {
+				t.Fatalf("ParsePosition() returned error: %v", err)
+			}
+			move := game.Move{Player: start.CurrentPlayer, Row: tt.move[0], Col: tt.move[1]}
+			annotations, err := New().Annotate(start, []game.Move{move})
+			if err != nil {
+				t.Fatalf("Annotate() returned error: %v", err)
+			}
+			a := annotations[0]
+			if a.Quality != tt.want {
+				t.Errorf("Quality = %v (%v -> %v), want %v", a.Quality, a.Before, a.After, tt.want)
+			}
+			if len(a.Best) == 0 || a.Best[0] != tt.wantBest {
+				t.Errorf("Best = %v, want %v", a.Best, tt.wantBest)
+			}
+		})
+	}
+}
+
+// TestAnnotateErrors verifies illegal move lists are rejected
+func TestAnnotateErrors(t *testing.T) {
+	start := game.NewGame()
+	tests := []struct {
+		name    string
+		start   game.Game
+		moves   []game.Move
+		wantErr error
+	}{
+		{"Out of turn", start, []game.Move{{Player: game.Player2, Row: 1, Col: 1}}, game.ErrInvalidHistory},
+		{"Occupied cell", start, []game.Move{{Player: game.Player1, Row: 1, Col: 1}, {Player: game.Player2, Row: 1, Col: 1}}, game.ErrInvalidHistory},
+	}
+	big, _ := game.NewGameWithConfig(game.Config{Width: 4, Height: 4, WinLength: 3})
+	tests = append(tests, struct {
+		name    string
+		start   game.Game
+		moves   []game.Move
+		wantErr error
+	}{"Too large", big, []game.Move{{Player: game.Player1, Row: 0, Col: 0}}, ErrTooLarge})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New().Annotate(tt.start, tt.moves); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Annotate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
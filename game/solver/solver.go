@@ -0,0 +1,253 @@
+// Package solver computes the game-theoretic value of tic-tac-toe positions
+//
+// A Solver searches the full game tree below a position and remembers every
+// position it has solved in a transposition table, so repeated queries,
+// positions reached by different move orders and positions that are
+// rotations or reflections of each other are solved only once
+package solver
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// MaxEmptyCells is the largest number of empty cells a Solver searches
+// Beyond it the game tree is too large to solve exhaustively in reasonable
+// time and memory
+const MaxEmptyCells = 12
+
+// ErrTooLarge indicates a position with more than MaxEmptyCells empty cells
+var ErrTooLarge = errors.New("position is too large to solve exhaustively")
+
+// ErrUnsupportedVariant indicates a game whose rules the solver cannot search
+// Under wild rules a move also chooses its mark, which MoveResult cannot express
+var ErrUnsupportedVariant = errors.New("the solver does not support the wild variant")
+
+// Value is the game-theoretic outcome of a position for the side to move
+type Value int
+
+const (
+	// Loss means the opponent wins against any defence
+	Loss Value = iota - 1
+	// Draw means neither side can force a win
+	Draw
+	// Win means the side to move wins against any defence
+	Win
+)
+
+// String returns "loss", "draw" or "win"
+func (v Value) String() string {
+	switch v {
+	case Loss:
+		return "loss"
+	case Draw:
+		return "draw"
+	case Win:
+		return "win"
+	default:
+		return "unknown"
+	}
+}
+
+// Result is the solution of a position from the point of view of the side to move
+type Result struct {
+	Value Value
+	// Distance is the number of plies until the game ends when both sides
+	// play best: the winner wins as fast as possible and the loser holds out
+	// as long as possible. It is 0 for a finished game
+	Distance int
+	// Moves lists every move that keeps Value, best first (see MoveResult),
+	// then in row-major order. It is empty for a finished game
+	Moves []game.Position
+}
+
+// Best returns the first of Moves, or false for a finished game
+func (r Result) Best() (game.Position, bool) {
+	if len(r.Moves) == 0 {
+		return game.Position{}, false
+	}
+	return r.Moves[0], true
+}
+
+// MoveResult is the solution of one legal move from the point of view of the
+// player making it
+type MoveResult struct {
+	game.Position
+	Value    Value
+	Distance int // Plies until the game ends, counting this move
+}
+
+// better reports whether m is a better outcome than other for the mover:
+// a higher value, then a faster win, a slower loss or, between draws, either
+func (m MoveResult) better(other MoveResult) bool {
+	if m.Value != other.Value {
+		return m.Value > other.Value
+	}
+	switch m.Value {
+	case Win:
+		return m.Distance < other.Distance
+	case Loss:
+		return m.Distance > other.Distance
+	default:
+		return false
+	}
+}
+
+// entry is the transposition table record of one solved position
+type entry struct {
+	value    Value
+	distance int
+}
+
+// Solver solves positions by exhaustive search with a transposition table
+// The table only grows, so a Solver can be reused across games to answer
+// later queries faster. A Solver is not safe for concurrent use
+type Solver struct {
+	table map[string]entry // Keyed by canonical position, see tableKey
+}
+
+// New returns a Solver with an empty transposition table
+func New() *Solver {
+	return &Solver{table: make(map[string]entry)}
+}
+
+// Size returns the number of positions in the transposition table
+func (s *Solver) Size() int {
+	return len(s.table)
+}
+
+// Solve returns the value of g for the side to move, the distance to the
+// result and every optimal move
+// For a finished game the side to move is the player who did not move last,
+// so the value is Loss or Draw, or Win or Draw under misere rules
+// Returns ErrTooLarge if the board has more than MaxEmptyCells empty cells,
+// and ErrUnsupportedVariant for a wild game
+func (s *Solver) Solve(g game.Game) (Result, error) {
+	moves, err := s.Analyze(g)
+	if err != nil {
+		return Result{}, err
+	}
+	if len(moves) == 0 {
+		return Result{Value: terminalValue(g)}, nil
+	}
+
+	r := Result{Value: moves[0].Value, Distance: moves[0].Distance}
+	for _, m := range moves {
+		if m.Value == r.Value {
+			r.Moves = append(r.Moves, m.Position)
+		}
+	}
+	s.table[tableKey(g)] = entry{value: r.Value, distance: r.Distance}
+	return r, nil
+}
+
+// SolveBoard solves board with player to move under config
+// The position is built and validated with game.FromBoard
+func (s *Solver) SolveBoard(config game.Config, board game.Board, player game.Player) (Result, error) {
+	g, err := game.FromBoard(config, board, player)
+	if err != nil {
+		return Result{}, err
+	}
+	return s.Solve(g)
+}
+
+// Analyze solves every legal move in g, best first and then in row-major order
+// Returns no moves for a finished game, ErrTooLarge if the board has more
+// than MaxEmptyCells empty cells and ErrUnsupportedVariant for a wild game
+func (s *Solver) Analyze(g game.Game) ([]MoveResult, error) {
+	if g.Config.Variant == game.Wild {
+		return nil, ErrUnsupportedVariant
+	}
+	if err := checkSize(g.Board); err != nil {
+		return nil, err
+	}
+	return s.analyze(g), nil
+}
+
+// solve returns the value and distance of g, searching below g if it is not yet solved
+func (s *Solver) solve(g game.Game) entry {
+	if g.State != game.InProgress {
+		return entry{value: terminalValue(g)}
+	}
+
+	key := tableKey(g)
+	if e, ok := s.table[key]; ok {
+		return e
+	}
+
+	best := s.analyze(g)[0]
+	e := entry{value: best.Value, distance: best.Distance}
+	s.table[key] = e
+	return e
+}
+
+// tableKey returns the position string of g with its board replaced by the
+// canonical board, so that symmetric positions share one table entry
+// Every symmetry maps winning lines onto winning lines, so symmetric
+// positions have the same value and distance
+func tableKey(g game.Game) string {
+	g.Board, _ = g.Board.Canonical()
+	return g.String()
+}
+
+// analyze solves every legal move in g and sorts them best first
+func (s *Solver) analyze(g game.Game) []MoveResult {
+	if g.State != game.InProgress {
+		return nil
+	}
+
+	var moves []MoveResult
+	for row := 0; row < g.Board.Height(); row++ {
+		for col := 0; col < g.Board.Width(); col++ {
+			next, err := g.MakeMove(row, col)
+			if err != nil {
+				continue
+			}
+			reply := s.solve(next)
+			moves = append(moves, MoveResult{
+				Position: game.Position{Row: row, Col: col},
+				Value:    -reply.value,
+				Distance: reply.distance + 1,
+			})
+		}
+	}
+
+	slices.SortStableFunc(moves, func(a, b MoveResult) int {
+		switch {
+		case a.better(b):
+			return -1
+		case b.better(a):
+			return 1
+		default:
+			return 0
+		}
+	})
+	return moves
+}
+
+// terminalValue values a finished game for the side that would move next
+// Only the player who moved last can have completed a line, so under
+// standard rules the side to move has lost or drawn, and under misere rules
+// it has won or drawn
+func terminalValue(g game.Game) Value {
+	switch {
+	case g.State == game.Draw:
+		return Draw
+	case g.Config.Variant == game.Misere:
+		return Win
+	default:
+		return Loss
+	}
+}
+
+// checkSize returns ErrTooLarge if board has more than MaxEmptyCells empty cells
+func checkSize(board game.Board) error {
+	empty := board.Width()*board.Height() - board.CountOccupied()
+	if empty > MaxEmptyCells {
+		return fmt.Errorf("%w: %d empty cells, at most %d", ErrTooLarge, empty, MaxEmptyCells)
+	}
+	return nil
+}
@@ -0,0 +1,269 @@
+package solver
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// mustParse parses a position string or fails the test
+func mustParse(t *testing.T, position string) game.Game {
+	t.Helper()
+	g, err := game.ParsePosition(position)
+	if err != nil {
+		t.Fatalf("ParsePosition(%q) returned error: %v", position, err)
+	}
+	return g
+}
+
+// TestSolveEmptyBoardIsDraw verifies classic tic-tac-toe is a draw with every opening move
+func TestSolveEmptyBoardIsDraw(t *testing.T) {
+	r, err := New().Solve(game.NewGame())
+	if err != nil {
+		t.Fatalf("Solve() returned error: %v", err)
+	}
+	if r.Value != Draw || r.Distance != 9 {
+		t.Errorf("Solve(empty) = %v in %d, want draw in 9", r.Value, r.Distance)
+	}
+	if len(r.Moves) != 9 {
+		t.Errorf("Solve(empty) has %d optimal moves, want all 9", len(r.Moves))
+	}
+}
+
+// TestSolveMisere verifies completing a line counts as a loss under misere rules
+func TestSolveMisere(t *testing.T) {
+	tests := []struct {
+		name         string
+		position     string
+		wantValue    Value
+		wantDistance int
+		wantMoves    [][2]int
+	}{
+		{"Empty board draws only through the centre", ".../.../... x misere", Draw, 9, [][2]int{{1, 1}}},
+		{"Avoid completing the row", "XX./OO./... x misere", Draw, 5, [][2]int{{2, 0}, {2, 1}, {2, 2}}},
+		{"Every move loses", "XO./.X./O.. x misere", Loss, 5, [][2]int{{0, 2}, {1, 0}, {1, 2}, {2, 1}, {2, 2}}},
+		{"Side to move has won", "XXX/OO./... o misere", Win, 0, nil},
+	}
+
+	s := New()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := s.Solve(mustParse(t, tt.position))
+			if err != nil {
+				t.Fatalf("Solve() returned error: %v", err)
+			}
+			if r.Value != tt.wantValue || r.Distance != tt.wantDistance {
+				t.Errorf("Solve(%q) = %v in %d, want %v in %d", tt.position, r.Value, r.Distance, tt.wantValue, tt.wantDistance)
+			}
+			var want []game.Position
+			for _, m := range tt.wantMoves {
+				want = append(want, game.Position{Row: m[0], Col: m[1]})
+			}
+			if !slices.Equal(r.Moves, want) {
+				t.Errorf("Solve(%q) moves = %v, want %v", tt.position, r.Moves, want)
+			}
+		})
+	}
+
+	// The same board under standard rules is a different table entry
+	r, err := s.Solve(mustParse(t, "XX./OO./... x"))
+	if err != nil || r.Value != Win || r.Distance != 1 {
+		t.Errorf("Solve(standard) = %v in %d, %v; want win in 1", r.Value, r.Distance, err)
+	}
+}
+
+// TestSolvePositions verifies values, distances and optimal moves of known positions
+func TestSolvePositions(t *testing.T) {
+	tests := []struct {
+		name         string
+		position     string
+		wantValue    Value
+		wantDistance int
+		wantMoves    [][2]int
+	}{
+		{"Win in one", "XX./OO./... x", Win, 1, [][2]int{{0, 2}}},
+		{"Must block", "XX./.O./... o", Draw, 6, [][2]int{{0, 2}}},
+		{"Opposite corners need an edge", "X../.O./..X o", Draw, 6, [][2]int{{0, 1}, {1, 0}, {1, 2}, {2, 1}}},
+		{"Corner opening needs the center", "X../.../... o", Draw, 8, [][2]int{{1, 1}}},
+		{"Lost position", "X.X/.O./O.X o", Loss, 2, [][2]int{{0, 1}, {1, 0}, {1, 2}, {2, 1}}},
+		{"Finished win", "XXX/OO./... o", Loss, 0, nil},
+		{"Finished draw", "XOX/XOO/OXX o", Draw, 0, nil},
+		{"Bigger board", "XXX./OOO./..../.... x 4", Win, 1, [][2]int{{0, 3}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := New().Solve(mustParse(t, tt.position))
+			if err != nil {
+				t.Fatalf("Solve() returned error: %v", err)
+			}
+			if r.Value != tt.wantValue || r.Distance != tt.wantDistance {
+				t.Errorf("Solve() = %v in %d, want %v in %d", r.Value, r.Distance, tt.wantValue, tt.wantDistance)
+			}
+			var want []game.Position
+			for _, m := range tt.wantMoves {
+				want = append(want, game.Position{Row: m[0], Col: m[1]})
+			}
+			if tt.wantMoves != nil && !slices.Equal(r.Moves, want) {
+				t.Errorf("Solve() moves = %v, want %v", r.Moves, want)
+			}
+		})
+	}
+}
+
+// TestSolveOptimalMovesPreserveValue verifies, for every position reachable
+// from the empty board, that each optimal move keeps the value and every
+// other move makes it worse
+func TestSolveOptimalMovesPreserveValue(t *testing.T) {
+	s := New()
+	seen := map[string]bool{}
+	canonical := map[string]bool{}
+
+	var walk func(g game.Game)
+	walk = func(g game.Game) {
+		if g.State != game.InProgress || seen[g.String()] {
+			return
+		}
+		seen[g.String()] = true
+		canonical[tableKey(g)] = true
+
+		r, err := s.Solve(g)
+		if err != nil {
+			t.Fatalf("Solve(%q) returned error: %v", g, err)
+		}
+		for row := 0; row < g.Board.Height(); row++ {
+			for col := 0; col < g.Board.Width(); col++ {
+				next, err := g.MakeMove(row, col)
+				if err != nil {
+					continue
+				}
+				reply, err := s.Solve(next)
+				if err != nil {
+					t.Fatalf("Solve(%q) returned error: %v", next, err)
+				}
+				optimal := slices.Contains(r.Moves, game.Position{Row: row, Col: col})
+				if kept := -reply.Value == r.Value; kept != optimal {
+					t.Fatalf("%q: move (%d,%d) listed optimal = %v but leads to %v for the mover, position is %v",
+						g, row, col, optimal, -reply.Value, r.Value)
+				}
+				if -reply.Value > r.Value {
+					t.Fatalf("%q: move (%d,%d) is better than the solved value %v", g, row, col, r.Value)
+				}
+				walk(next)
+			}
+		}
+	}
+	walk(game.NewGame())
+
+	// 4520 in-progress positions, 627 up to symmetry
+	if len(seen) != 4520 || s.Size() != len(canonical) || s.Size() != 627 {
+		t.Errorf("Solver table has %d positions for %d visited, %d up to symmetry; want 627 for 4520",
+			s.Size(), len(seen), len(canonical))
+	}
+}
+
+// TestAnalyze verifies every legal move is solved and sorted best first
+func TestAnalyze(t *testing.T) {
+	moves, err := New().Analyze(mustParse(t, "XX./OO./... o"))
+	if err != nil {
+		t.Fatalf("Analyze() returned error: %v", err)
+	}
+	if len(moves) != 5 {
+		t.Fatalf("Analyze() returned %d moves, want 5", len(moves))
+	}
+
+	want := MoveResult{Position: game.Position{Row: 1, Col: 2}, Value: Win, Distance: 1}
+	if moves[0] != want {
+		t.Errorf("Analyze()[0] = %+v, want %+v", moves[0], want)
+	}
+	for i := 1; i < len(moves); i++ {
+		if moves[i].better(moves[i-1]) {
+			t.Errorf("Analyze() is not sorted: %+v before %+v", moves[i-1], moves[i])
+		}
+	}
+	if last := moves[len(moves)-1]; last.Value != Loss {
+		t.Errorf("Analyze() worst move = %+v, want a loss", last)
+	}
+}
+
+// TestSolveBoard verifies boards are validated before solving
+func TestSolveBoard(t *testing.T) {
+	s := New()
+	board := game.NewBoard().SetCell(1, 1, game.X)
+
+	r, err := s.SolveBoard(game.StandardConfig(), board, game.Player2)
+	if err != nil {
+		t.Fatalf("SolveBoard() returned error: %v", err)
+	}
+	if r.Value != Draw {
+		t.Errorf("SolveBoard() value = %v, want draw", r.Value)
+	}
+
+	if _, err := s.SolveBoard(game.StandardConfig(), board, game.Player1); !errors.Is(err, game.ErrInvalidMarkCount) {
+		t.Errorf("SolveBoard() with the wrong side to move error = %v, want ErrInvalidMarkCount", err)
+	}
+}
+
+// TestSolveTooLarge verifies boards with too many empty cells are refused
+func TestSolveTooLarge(t *testing.T) {
+	g, err := game.NewGameWithConfig(game.Config{Width: 4, Height: 4, WinLength: 4})
+	if err != nil {
+		t.Fatalf("NewGameWithConfig() returned error: %v", err)
+	}
+	if _, err := New().Solve(g); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("Solve(4x4) error = %v, want ErrTooLarge", err)
+	}
+	if _, err := New().Analyze(g); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("Analyze(4x4) error = %v, want ErrTooLarge", err)
+	}
+}
+
+// TestSolveWild verifies wild games are refused
+func TestSolveWild(t *testing.T) {
+	g, err := game.NewGameWithConfig(game.Config{Width: 3, Height: 3, WinLength: 3, Variant: game.Wild})
+	if err != nil {
+		t.Fatalf("NewGameWithConfig() returned error: %v", err)
+	}
+	if _, err := New().Solve(g); !errors.Is(err, ErrUnsupportedVariant) {
+		t.Errorf("Solve(wild) error = %v, want ErrUnsupportedVariant", err)
+	}
+}
+
+// TestSolveSymmetricPositions verifies rotated and reflected positions share a
+// table entry yet report moves on their own board
+func TestSolveSymmetricPositions(t *testing.T) {
+	s := New()
+	corner, err := s.Solve(mustParse(t, "X../.../... o"))
+	if err != nil {
+		t.Fatalf("Solve() returned error: %v", err)
+	}
+	size := s.Size()
+
+	rotated, err := s.Solve(mustParse(t, "..X/.../... o"))
+	if err != nil {
+		t.Fatalf("Solve() returned error: %v", err)
+	}
+	if s.Size() != size {
+		t.Errorf("Solving a rotated position grew the table from %d to %d", size, s.Size())
+	}
+	if rotated.Value != corner.Value || rotated.Distance != corner.Distance {
+		t.Errorf("Rotated position = %v in %d, want %v in %d", rotated.Value, rotated.Distance, corner.Value, corner.Distance)
+	}
+	if !slices.Equal(rotated.Moves, []game.Position{{Row: 1, Col: 1}}) {
+		t.Errorf("Rotated position moves = %v, want the center", rotated.Moves)
+	}
+}
+
+// TestSolveResultIsCopied verifies callers cannot corrupt the transposition table
+func TestSolveResultIsCopied(t *testing.T) {
+	s := New()
+	first, _ := s.Solve(game.NewGame())
+	first.Moves[0] = game.Position{Row: 9, Col: 9}
+
+	second, _ := s.Solve(game.NewGame())
+	if second.Moves[0] != (game.Position{Row: 0, Col: 0}) {
+		t.Errorf("Solve() moves[0] = %v after modifying an earlier result, want (0,0)", second.Moves[0])
+	}
+}
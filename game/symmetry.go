@@ -0,0 +1,119 @@
+package game
+
+import "fmt"
+
+// Transform is one of the eight symmetries of a square board (the dihedral
+// group D4): an optional left-right mirror followed by 0 to 3 clockwise
+// quarter turns
+// Rotating a non-square board by a quarter turn swaps its width and height
+type Transform struct {
+	Reflect bool // Mirror columns left to right before turning
+	Turns   int  // Clockwise quarter turns, 0 to 3
+}
+
+// Identity is the transform that leaves every board unchanged
+var Identity = Transform{}
+
+// Symmetries lists every transform of a square board, Identity first
+var Symmetries = [...]Transform{
+	{Turns: 0}, {Turns: 1}, {Turns: 2}, {Turns: 3},
+	{Reflect: true, Turns: 0}, {Reflect: true, Turns: 1}, {Reflect: true, Turns: 2}, {Reflect: true, Turns: 3},
+}
+
+// String describes the transform, e.g. "reflect, rotate 90"
+func (t Transform) String() string {
+	turns := t.Turns % 4
+	switch {
+	case !t.Reflect && turns == 0:
+		return "identity"
+	case !t.Reflect:
+		return fmt.Sprintf("rotate %d", 90*turns)
+	case turns == 0:
+		return "reflect"
+	default:
+		return fmt.Sprintf("reflect, rotate %d", 90*turns)
+	}
+}
+
+// Inverse returns the transform that undoes t
+// Every reflecting transform is its own inverse
+func (t Transform) Inverse() Transform {
+	if t.Reflect {
+		return t
+	}
+	return Transform{Turns: (4 - t.Turns%4) % 4}
+}
+
+// Position maps the cell p of a width x height board to the cell it moves to
+// when t is applied to the board
+// Use t.Inverse().Position with the transformed board's dimensions to map a
+// move on the transformed board back to the original
+func (t Transform) Position(p Position, width, height int) Position {
+	if t.Reflect {
+		p.Col = width - 1 - p.Col
+	}
+	for range t.Turns % 4 {
+		// A clockwise quarter turn sends (row, col) to (col, height-1-row)
+		p = Position{Row: p.Col, Col: height - 1 - p.Row}
+		width, height = height, width
+	}
+	return p
+}
+
+// Apply returns a new board with t applied to b
+func (t Transform) Apply(b Board) Board {
+	width, height := b.Width(), b.Height()
+	if t.Turns%2 == 1 {
+		width, height = height, width
+	}
+
+	result := NewBoardSize(width, height)
+	for row := range b {
+		for col, cell := range b[row] {
+			p := t.Position(Position{Row: row, Col: col}, b.Width(), b.Height())
+			result[p.Row][p.Col] = cell
+		}
+	}
+	return result
+}
+
+// Rotate90 returns a new board turned a quarter turn clockwise
+func (b Board) Rotate90() Board {
+	return Transform{Turns: 1}.Apply(b)
+}
+
+// Reflect returns a new board mirrored left to right
+func (b Board) Reflect() Board {
+	return Transform{Reflect: true}.Apply(b)
+}
+
+// Canonical returns the lexicographically smallest board equivalent to b
+// under the symmetries that keep its dimensions, together with the transform
+// that produces it from b. Boards compare cell by cell in row-major order
+// with Empty < X < O. Ties go to the earliest transform in Symmetries, so a
+// board that is already canonical returns Identity
+// Non-square boards only have the four symmetries without a quarter turn
+func (b Board) Canonical() (Board, Transform) {
+	best, bestTransform := b, Identity
+	for _, t := range Symmetries[1:] {
+		if t.Turns%2 == 1 && b.Width() != b.Height() {
+			continue
+		}
+		if candidate := t.Apply(b); compareBoards(candidate, best) < 0 {
+			best, bestTransform = candidate, t
+		}
+	}
+	return best, bestTransform
+}
+
+// compareBoards orders boards of equal dimensions cell by cell in row-major order
+func compareBoards(a, b Board) int {
+	for row := range a {
+		for col := range a[row] {
+			if a[row][col] != b[row][col] {
+				return int(a[row][col]) - int(b[row][col])
+			}
+		}
+	}
+	return 0
+}
@@ -0,0 +1,131 @@
+package game
+
+import "testing"
+
+// mustParseBoard parses a board string or fails the test
+func mustParseBoard(t *testing.T, s string) Board {
+	t.Helper()
+	b, err := ParseBoard(s)
+	if err != nil {
+		t.Fatalf("ParseBoard(%q) returned error: %v", s, err)
+	}
+	return b
+}
+
+// TestRotateReflect verifies quarter turns and mirrors, including on non-square boards
+func TestRotateReflect(t *testing.T) {
+	tests := []struct {
+		name  string
+		board string
+		apply func(Board) Board
+		want  string
+	}{
+		{"Rotate corner", "X../.../...", Board.Rotate90, "..X/.../..."},
+		{"Rotate row", "XOX/.../...", Board.Rotate90, "..X/..O/..X"},
+		{"Reflect", "XO./.X./..O", Board.Reflect, ".OX/.X./O.."},
+		{"Rotate 3x2", "XO./..O", Board.Rotate90, ".X/.O/O."},
+		{"Reflect 3x2", "XO./..O", Board.Reflect, ".OX/O.."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.apply(mustParseBoard(t, tt.board)).String(); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestTransformInverse verifies every symmetry is undone by its inverse, for
+// both boards and moves
+func TestTransformInverse(t *testing.T) {
+	for _, size := range [][2]int{{3, 3}, {4, 2}} {
+		board := NewBoardSize(size[0], size[1]).SetCell(0, 0, X).SetCell(0, 1, O).SetCell(1, 1, X)
+		for _, tr := range Symmetries {
+			transformed := tr.Apply(board)
+			if back := tr.Inverse().Apply(transformed); !back.Equal(board) {
+				t.Errorf("%dx%d %v: inverse gives %q, want %q", size[0], size[1], tr, back, board)
+			}
+
+			for row := range board {
+				for col := range board[row] {
+					p := tr.Position(Position{Row: row, Col: col}, board.Width(), board.Height())
+					if transformed.GetCell(p.Row, p.Col) != board.GetCell(row, col) {
+						t.Errorf("%dx%d %v: (%d,%d) maps to %v holding a different cell", size[0], size[1], tr, row, col, p)
+					}
+					back := tr.Inverse().Position(p, transformed.Width(), transformed.Height())
+					if back != (Position{Row: row, Col: col}) {
+						t.Errorf("%dx%d %v: (%d,%d) maps back to %v", size[0], size[1], tr, row, col, back)
+					}
+				}
+			}
+		}
+	}
+}
+
+// TestCanonical verifies equivalent boards share one canonical form
+func TestCanonical(t *testing.T) {
+	tests := []struct {
+		name  string
+		board string
+		want  string
+	}{
+		{"Empty", ".../.../...", ".../.../..."},
+		{"Any corner", "..X/.../...", ".../.../..X"},
+		{"Any edge", ".../X../...", ".../.../.X."},
+		{"Center is fixed", ".../.X./...", ".../.X./..."},
+		{"Two marks", "O../.../..X", "..X/.../O.."},
+		{"Rectangular", "X.../....", "..../...X"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			board := mustParseBoard(t, tt.board)
+			canonical, tr := board.Canonical()
+			if canonical.String() != tt.want {
+				t.Errorf("Canonical() = %q, want %q", canonical, tt.want)
+			}
+			if !tr.Apply(board).Equal(canonical) {
+				t.Errorf("Canonical() transform %v does not produce the canonical board", tr)
+			}
+		})
+	}
+}
+
+// TestCanonicalInvariant verifies every symmetric image of a board has the same canonical form
+func TestCanonicalInvariant(t *testing.T) {
+	board := mustParseBoard(t, "XO./..X/O..")
+	want, _ := board.Canonical()
+
+	for _, tr := range Symmetries {
+		got, _ := tr.Apply(board).Canonical()
+		if !got.Equal(want) {
+			t.Errorf("Canonical() of %v image = %q, want %q", tr, got, want)
+		}
+	}
+
+	if _, tr := want.Canonical(); tr != Identity {
+		t.Errorf("Canonical() of a canonical board used %v, want identity", tr)
+	}
+}
+
+// TestTransformString verifies transforms describe themselves
+func TestTransformString(t *testing.T) {
+	tests := []struct {
+		transform Transform
+		want      string
+	}{
+		{Identity, "identity"},
+		{Transform{Turns: 3}, "rotate 270"},
+		{Transform{Reflect: true}, "reflect"},
+		{Transform{Reflect: true, Turns: 1}, "reflect, rotate 90"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.transform.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
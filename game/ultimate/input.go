@@ -0,0 +1,53 @@
+package ultimate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+	"github.com/YOUR_USERNAME/tictactoe/validation"
+)
+
+// ErrBoardRequired indicates a two-number move when the player may choose any board
+var ErrBoardRequired = &game.GameError{Message: "Choose a board too: enter board row, board column, row and column, e.g. '1 1 0 2'"}
+
+// ParseMove parses a move typed by the current player
+// Four numbers give the sub-board's row and column followed by the cell's
+// row and column, e.g. "1 1 0 2". When the player is sent to a single
+// sub-board, two numbers give the cell within it, e.g. "0 2"
+// Every number must be between 0 and 2
+func (g Game) ParseMove(input string) (board, cell game.Position, err error) {
+	fields := strings.Fields(input)
+	switch len(fields) {
+	case 0, 1, 3:
+		return board, cell, fmt.Errorf("%w: enter 'row col' or 'boardRow boardCol row col'", validation.ErrIncompleteInput)
+	case 2, 4:
+	default:
+		return board, cell, fmt.Errorf("%w: expected 2 or 4 numbers, got %d", validation.ErrInvalidFormat, len(fields))
+	}
+
+	numbers := make([]int, len(fields))
+	for i, field := range fields {
+		if numbers[i], err = validation.ValidateNumeric(field); err != nil {
+			return board, cell, err
+		}
+	}
+
+	if len(numbers) == 2 {
+		if g.AnyBoard {
+			return board, cell, ErrBoardRequired
+		}
+		board = g.Next
+	} else {
+		board = game.Position{Row: numbers[0], Col: numbers[1]}
+		numbers = numbers[2:]
+	}
+	cell = game.Position{Row: numbers[0], Col: numbers[1]}
+
+	for _, p := range []game.Position{board, cell} {
+		if err := validation.ValidateRangeSize(p.Row, p.Col, Size, Size); err != nil {
+			return game.Position{}, game.Position{}, err
+		}
+	}
+	return board, cell, nil
+}
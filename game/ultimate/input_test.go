@@ -0,0 +1,49 @@
+package ultimate
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+	"github.com/YOUR_USERNAME/tictactoe/validation"
+)
+
+// TestParseMove verifies full and short move input
+func TestParseMove(t *testing.T) {
+	free := NewGame()
+	sent := free
+	sent.Next, sent.AnyBoard = pos(0, 2), false
+
+	tests := []struct {
+		name      string
+		g         Game
+		input     string
+		wantBoard game.Position
+		wantCell  game.Position
+		wantErr   error
+	}{
+		{"Board and cell", free, "1 1 0 2", pos(1, 1), pos(0, 2), nil},
+		{"Extra spaces", free, "  2 0   1 1 ", pos(2, 0), pos(1, 1), nil},
+		{"Cell in the forced board", sent, "2 1", pos(0, 2), pos(2, 1), nil},
+		{"Board and cell when forced", sent, "0 2 2 1", pos(0, 2), pos(2, 1), nil},
+		{"Cell only when free", free, "1 1", game.Position{}, game.Position{}, ErrBoardRequired},
+		{"Empty", free, "", game.Position{}, game.Position{}, validation.ErrIncompleteInput},
+		{"Three numbers", free, "1 1 1", game.Position{}, game.Position{}, validation.ErrIncompleteInput},
+		{"Too many numbers", free, "1 1 1 1 1", game.Position{}, game.Position{}, validation.ErrInvalidFormat},
+		{"Not a number", free, "1 a 0 0", game.Position{}, game.Position{}, validation.ErrInvalidFormat},
+		{"Board out of range", free, "3 0 0 0", game.Position{}, game.Position{}, validation.ErrInvalidRange},
+		{"Cell out of range", sent, "0 -1", game.Position{}, game.Position{}, validation.ErrInvalidRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			board, cell, err := tt.g.ParseMove(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseMove(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+			}
+			if board != tt.wantBoard || cell != tt.wantCell {
+				t.Errorf("ParseMove(%q) = %v %v, want %v %v", tt.input, board, cell, tt.wantBoard, tt.wantCell)
+			}
+		})
+	}
+}
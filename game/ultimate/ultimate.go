@@ -0,0 +1,157 @@
+// Package ultimate implements Ultimate Tic-Tac-Toe
+//
+// The board is a 3x3 grid of classic 3x3 boards. The cell a player picks
+// inside a sub-board decides which sub-board the opponent must play in next:
+// playing the top-right cell of any sub-board sends the opponent to the
+// top-right sub-board. Winning a sub-board claims that square of the meta
+// board, and three claimed squares in a row on the meta board win the game
+//
+// A sub-board is closed once it is won or full. A player sent to a closed
+// sub-board may instead play in any sub-board that is still open. The game
+// is a draw when every sub-board is closed and neither player has a line on
+// the meta board
+package ultimate
+
+import (
+	"fmt"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// Size is the number of sub-boards along each side of the meta board, and
+// the number of cells along each side of a sub-board
+const Size = game.BOARD_SIZE
+
+// Error types for Ultimate moves
+var (
+	ErrGameOver      = &game.GameError{Message: "The game is over. No more moves can be played"}
+	ErrWrongBoard    = &game.GameError{Message: "Wrong board. Your opponent's last move decides which board you play in"}
+	ErrBoardFinished = &game.GameError{Message: "That board is already finished. Choose a board that is still open"}
+)
+
+// Move records a single Ultimate move and the game state it produced
+type Move struct {
+	Player game.Player    // Player who made the move
+	Board  game.Position  // Sub-board played in, as a meta board row and column
+	Cell   game.Position  // Cell played within the sub-board
+	State  game.GameState // Game state after the move
+}
+
+// Game represents an Ultimate game
+// Like game.Game it is an immutable value: MakeMove returns a new Game
+type Game struct {
+	Boards        [Size][Size]game.Board     // The nine sub-boards, by meta row and column
+	States        [Size][Size]game.GameState // Result of each sub-board
+	Meta          game.Board                 // Winner of each sub-board; Empty while open or drawn
+	CurrentPlayer game.Player                // Whose turn it is
+	State         game.GameState             // Result of the whole game
+	Next          game.Position              // Sub-board the current player must play in, unless AnyBoard
+	AnyBoard      bool                       // True on the first move and after being sent to a closed board
+	MoveCount     int                        // Number of moves made
+	History       []Move                     // Moves played so far, oldest first
+}
+
+// NewGame creates a new Ultimate game with Player 1 (X) free to play in any board
+func NewGame() Game {
+	g := Game{
+		Meta:          game.NewBoard(),
+		CurrentPlayer: game.Player1,
+		State:         game.InProgress,
+		AnyBoard:      true,
+	}
+	for row := range Size {
+		for col := range Size {
+			g.Boards[row][col] = game.NewBoard()
+		}
+	}
+	return g
+}
+
+// IsOpen returns true if the sub-board at board can still be played in
+func (g Game) IsOpen(board game.Position) bool {
+	return g.States[board.Row][board.Col] == game.InProgress
+}
+
+// CanPlayIn returns true if the current player may play in the sub-board at board
+func (g Game) CanPlayIn(board game.Position) bool {
+	return g.State == game.InProgress && inRange(board) && g.IsOpen(board) && (g.AnyBoard || board == g.Next)
+}
+
+// MakeMove places the current player's mark in cell of the sub-board at board
+// Returns a new game state with the sub-board and meta board results updated
+// and the opponent sent to the sub-board matching cell
+// Returns ErrGameOver once the game is finished, game.ErrInvalidRange for
+// coordinates outside 0-2, ErrBoardFinished or ErrWrongBoard for a sub-board
+// the player may not use, and game.ErrCellOccupied for a taken cell
+func (g Game) MakeMove(board, cell game.Position) (Game, error) {
+	switch {
+	case g.State != game.InProgress:
+		return g, ErrGameOver
+	case !inRange(board) || !inRange(cell):
+		return g, game.ErrInvalidRange
+	case !g.IsOpen(board):
+		return g, ErrBoardFinished
+	case !g.AnyBoard && board != g.Next:
+		return g, fmt.Errorf("%w: play in board %d %d", ErrWrongBoard, g.Next.Row, g.Next.Col)
+	}
+
+	sub := g.Boards[board.Row][board.Col]
+	if !sub.IsCellEmpty(cell.Row, cell.Col) {
+		return g, game.ErrCellOccupied
+	}
+
+	// Boards is an array, so the copy can be updated without touching g
+	newGame := g
+	mark := g.CurrentPlayer.GetMark()
+	sub = sub.SetCell(cell.Row, cell.Col, mark)
+	newGame.Boards[board.Row][board.Col] = sub
+	newGame.MoveCount++
+
+	switch {
+	case game.CheckWin(sub, mark):
+		newGame.States[board.Row][board.Col] = winState(g.CurrentPlayer)
+		newGame.Meta = g.Meta.SetCell(board.Row, board.Col, mark)
+	case sub.IsFull():
+		newGame.States[board.Row][board.Col] = game.Draw
+	}
+
+	switch {
+	case game.CheckWin(newGame.Meta, mark):
+		newGame.State = winState(g.CurrentPlayer)
+	case !newGame.anyOpen():
+		newGame.State = game.Draw
+	default:
+		newGame.CurrentPlayer = g.CurrentPlayer.Other()
+		newGame.Next = cell
+		newGame.AnyBoard = !newGame.IsOpen(cell)
+	}
+
+	move := Move{Player: g.CurrentPlayer, Board: board, Cell: cell, State: newGame.State}
+	newGame.History = append(g.History[:len(g.History):len(g.History)], move)
+	return newGame, nil
+}
+
+// anyOpen returns true if at least one sub-board can still be played in
+func (g Game) anyOpen() bool {
+	for row := range Size {
+		for col := range Size {
+			if g.States[row][col] == game.InProgress {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// winState returns the game state in which player has won
+func winState(player game.Player) game.GameState {
+	if player == game.Player1 {
+		return game.Player1Won
+	}
+	return game.Player2Won
+}
+
+// inRange returns true if p addresses a square of a 3x3 grid
+func inRange(p game.Position) bool {
+	return p.Row >= 0 && p.Row < Size && p.Col >= 0 && p.Col < Size
+}
@@ -0,0 +1,253 @@
+package ultimate
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// pos is shorthand for a game.Position
+func pos(row, col int) game.Position {
+	return game.Position{Row: row, Col: col}
+}
+
+// setBoard replaces the sub-board at board with the board written as s,
+// e.g. "XXX/OO./...", and updates its result and the meta board to match
+func setBoard(t *testing.T, g *Game, board game.Position, s string) {
+	t.Helper()
+	sub, err := game.ParseBoard(s)
+	if err != nil {
+		t.Fatalf("ParseBoard(%q) returned error: %v", s, err)
+	}
+	g.Boards[board.Row][board.Col] = sub
+	g.Meta = g.Meta.SetCell(board.Row, board.Col, game.Empty)
+	g.States[board.Row][board.Col] = game.InProgress
+	switch {
+	case game.CheckWin(sub, game.X):
+		g.States[board.Row][board.Col] = game.Player1Won
+		g.Meta = g.Meta.SetCell(board.Row, board.Col, game.X)
+	case game.CheckWin(sub, game.O):
+		g.States[board.Row][board.Col] = game.Player2Won
+		g.Meta = g.Meta.SetCell(board.Row, board.Col, game.O)
+	case sub.IsFull():
+		g.States[board.Row][board.Col] = game.Draw
+	}
+}
+
+// mustMove makes a move or fails the test
+func mustMove(t *testing.T, g Game, board, cell game.Position) Game {
+	t.Helper()
+	next, err := g.MakeMove(board, cell)
+	if err != nil {
+		t.Fatalf("MakeMove(%v, %v) returned error: %v", board, cell, err)
+	}
+	return next
+}
+
+// TestNewGame verifies the first player may play anywhere
+func TestNewGame(t *testing.T) {
+	g := NewGame()
+	if g.CurrentPlayer != game.Player1 || g.State != game.InProgress || !g.AnyBoard {
+		t.Errorf("NewGame() = player %v, state %v, any board %v; want X, in progress, true",
+			g.CurrentPlayer, g.State, g.AnyBoard)
+	}
+	for row := range Size {
+		for col := range Size {
+			if !g.CanPlayIn(pos(row, col)) {
+				t.Errorf("CanPlayIn(%d, %d) = false on a new game", row, col)
+			}
+		}
+	}
+}
+
+// TestMakeMoveSendsOpponent verifies the cell played picks the opponent's board
+func TestMakeMoveSendsOpponent(t *testing.T) {
+	g := mustMove(t, NewGame(), pos(1, 1), pos(0, 2))
+
+	if g.CurrentPlayer != game.Player2 || g.AnyBoard || g.Next != pos(0, 2) {
+		t.Fatalf("After move: player %v, any board %v, next %v; want O sent to 0 2", g.CurrentPlayer, g.AnyBoard, g.Next)
+	}
+	if g.Boards[1][1].GetCell(0, 2) != game.X {
+		t.Errorf("Sub-board 1 1 cell 0 2 = %v, want X", g.Boards[1][1].GetCell(0, 2))
+	}
+	if _, err := g.MakeMove(pos(2, 2), pos(0, 0)); !errors.Is(err, ErrWrongBoard) {
+		t.Errorf("MakeMove() in another board error = %v, want ErrWrongBoard", err)
+	}
+	if g.CanPlayIn(pos(2, 2)) || !g.CanPlayIn(pos(0, 2)) {
+		t.Error("CanPlayIn() should only allow board 0 2")
+	}
+
+	g = mustMove(t, g, pos(0, 2), pos(1, 1))
+	if g.Next != pos(1, 1) || g.AnyBoard {
+		t.Errorf("After reply: next %v, any board %v; want 1 1, false", g.Next, g.AnyBoard)
+	}
+}
+
+// TestSentToFinishedBoard verifies a player sent to a won or full board may play in any open board
+func TestSentToFinishedBoard(t *testing.T) {
+	tests := []struct {
+		name   string
+		setup  func(t *testing.T, g *Game)
+		board  game.Position // Board X plays in
+		cell   game.Position // Cell X plays, which sends O to that board
+		closed game.Position // The board O is sent to, closed after X's move
+	}{
+		{
+			name:   "Sent to a won board",
+			setup:  func(t *testing.T, g *Game) { setBoard(t, g, pos(0, 0), "XXX/OO./...") },
+			board:  pos(1, 1),
+			cell:   pos(0, 0),
+			closed: pos(0, 0),
+		},
+		{
+			name:   "Sent to a drawn board",
+			setup:  func(t *testing.T, g *Game) { setBoard(t, g, pos(2, 0), "XOX/XOO/OXX") },
+			board:  pos(1, 1),
+			cell:   pos(2, 0),
+			closed: pos(2, 0),
+		},
+		{
+			name:   "Sent to the board just won",
+			setup:  func(t *testing.T, g *Game) { setBoard(t, g, pos(1, 1), "X.O/.../O.X") },
+			board:  pos(1, 1),
+			cell:   pos(1, 1),
+			closed: pos(1, 1),
+		},
+		{
+			name:   "Sent to the board just filled",
+			setup:  func(t *testing.T, g *Game) { setBoard(t, g, pos(1, 1), "XOX/X.O/OXO") },
+			board:  pos(1, 1),
+			cell:   pos(1, 1),
+			closed: pos(1, 1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGame()
+			tt.setup(t, &g)
+			g.Next, g.AnyBoard = tt.board, false
+
+			g = mustMove(t, g, tt.board, tt.cell)
+			if !g.AnyBoard {
+				t.Fatalf("AnyBoard = false after sending O to closed board %v", tt.closed)
+			}
+			if g.IsOpen(tt.closed) || g.CanPlayIn(tt.closed) {
+				t.Errorf("Board %v should be closed", tt.closed)
+			}
+			if _, err := g.MakeMove(tt.closed, pos(2, 1)); !errors.Is(err, ErrBoardFinished) {
+				t.Errorf("MakeMove() in the closed board error = %v, want ErrBoardFinished", err)
+			}
+
+			// Any other open board is allowed
+			other := pos(2, 2)
+			if !g.CanPlayIn(other) {
+				t.Fatalf("CanPlayIn(%v) = false, want true", other)
+			}
+			g = mustMove(t, g, other, pos(0, 1))
+			if g.AnyBoard || g.Next != pos(0, 1) {
+				t.Errorf("After playing anywhere: any board %v, next %v; want X sent to 0 1", g.AnyBoard, g.Next)
+			}
+		})
+	}
+}
+
+// TestSubBoardWinClaimsMeta verifies winning a sub-board marks the meta board
+func TestSubBoardWinClaimsMeta(t *testing.T) {
+	g := NewGame()
+	setBoard(t, &g, pos(0, 1), "XX./OO./...")
+	g.Next, g.AnyBoard = pos(0, 1), false
+
+	g = mustMove(t, g, pos(0, 1), pos(0, 2))
+	if g.States[0][1] != game.Player1Won || g.Meta.GetCell(0, 1) != game.X {
+		t.Errorf("Sub-board 0 1: state %v, meta %v; want won by X", g.States[0][1], g.Meta.GetCell(0, 1))
+	}
+	if g.State != game.InProgress {
+		t.Errorf("State = %v, want in progress", g.State)
+	}
+}
+
+// TestMetaBoardDecidesGame verifies a line of sub-boards wins and closing every board draws
+func TestMetaBoardDecidesGame(t *testing.T) {
+	t.Run("Line of sub-boards wins", func(t *testing.T) {
+		g := NewGame()
+		setBoard(t, &g, pos(0, 0), "OOO/XX./X..")
+		setBoard(t, &g, pos(1, 1), "O../XOX/X.O")
+		setBoard(t, &g, pos(2, 2), "OO./XX./...")
+		g.CurrentPlayer, g.Next, g.AnyBoard = game.Player2, pos(2, 2), false
+
+		g = mustMove(t, g, pos(2, 2), pos(0, 2))
+		if g.State != game.Player2Won || g.CurrentPlayer != game.Player2 {
+			t.Errorf("State = %v, player %v; want O won", g.State, g.CurrentPlayer)
+		}
+		if _, err := g.MakeMove(pos(0, 1), pos(0, 0)); !errors.Is(err, ErrGameOver) {
+			t.Errorf("MakeMove() after the game error = %v, want ErrGameOver", err)
+		}
+	})
+
+	t.Run("Every board closed is a draw", func(t *testing.T) {
+		g := NewGame()
+		layout := [Size][Size]string{
+			{"XXX/.../...", "OOO/.../...", "XOX/XOO/OXX"},
+			{"OOO/.../...", "XXX/.../...", "XXX/.../..."},
+			{"XOX/XOO/OXX", "XOX/XOO/OXX", "XOX/X.O/OXO"},
+		}
+		for row := range Size {
+			for col := range Size {
+				setBoard(t, &g, pos(row, col), layout[row][col])
+			}
+		}
+		g.Next, g.AnyBoard = pos(2, 2), false
+
+		g = mustMove(t, g, pos(2, 2), pos(1, 1))
+		if g.State != game.Draw {
+			t.Errorf("State = %v, want draw", g.State)
+		}
+	})
+}
+
+// TestMakeMoveErrors verifies invalid moves are rejected without changing the game
+func TestMakeMoveErrors(t *testing.T) {
+	g := mustMove(t, NewGame(), pos(1, 1), pos(1, 1))
+	g = mustMove(t, g, pos(1, 1), pos(0, 0))
+	g = mustMove(t, g, pos(0, 0), pos(1, 1)) // O is sent back to board 1 1
+
+	tests := []struct {
+		name    string
+		board   game.Position
+		cell    game.Position
+		wantErr error
+	}{
+		{"Board out of range", pos(3, 0), pos(0, 0), game.ErrInvalidRange},
+		{"Cell out of range", pos(1, 1), pos(0, -1), game.ErrInvalidRange},
+		{"Occupied cell", pos(1, 1), pos(0, 0), game.ErrCellOccupied},
+		{"Wrong board", pos(0, 0), pos(2, 2), ErrWrongBoard},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next, err := g.MakeMove(tt.board, tt.cell)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("MakeMove(%v, %v) error = %v, want %v", tt.board, tt.cell, err, tt.wantErr)
+			}
+			if next.MoveCount != g.MoveCount {
+				t.Errorf("Rejected move changed MoveCount to %d", next.MoveCount)
+			}
+		})
+	}
+}
+
+// TestMakeMoveIsImmutable verifies MakeMove leaves the original game untouched
+func TestMakeMoveIsImmutable(t *testing.T) {
+	g := NewGame()
+	next := mustMove(t, g, pos(2, 1), pos(0, 1))
+
+	if g.Boards[2][1].GetCell(0, 1) != game.Empty || g.MoveCount != 0 || len(g.History) != 0 {
+		t.Error("MakeMove() modified the original game")
+	}
+	want := Move{Player: game.Player1, Board: pos(2, 1), Cell: pos(0, 1), State: game.InProgress}
+	if len(next.History) != 1 || next.History[0] != want {
+		t.Errorf("History = %+v, want [%+v]", next.History, want)
+	}
+}
@@ -0,0 +1,126 @@
+package game
+
+import "fmt"
+
+// ValidatePosition checks that g could have been reached by alternating play:
+// mark counts differ by at most one, the side to move is consistent with them,
+// MoveCount matches the board, and State agrees with the configured
+// CheckWin, Outcome and CheckDraw
+func ValidatePosition(g Game) error {
+	if err := validateMarkCounts(g); err != nil {
+		return err
+	}
+	return validateState(g)
+}
+
+// validateMarkCounts checks mark counts against the current player
+// While in progress the player to move never has more marks than the opponent;
+// once finished CurrentPlayer is the last mover and never has fewer
+// Under Wild rules either player may place either mark, so only MoveCount is checked
+func validateMarkCounts(g Game) error {
+	xCount, oCount := countMarks(g.Board, X), countMarks(g.Board, O)
+	if g.MoveCount != xCount+oCount {
+		return fmt.Errorf("%w: move count %d but %d marks on the board", ErrInvalidMarkCount, g.MoveCount, xCount+oCount)
+	}
+	if g.Config.Variant == Wild {
+		return nil
+	}
+	if xCount-oCount > 1 || oCount-xCount > 1 {
+		return fmt.Errorf("%w: %d X and %d O", ErrInvalidMarkCount, xCount, oCount)
+	}
+
+	mine := countMarks(g.Board, g.CurrentPlayer.GetMark())
+	theirs := countMarks(g.Board, g.CurrentPlayer.Other().GetMark())
+	if g.State == InProgress && mine > theirs {
+		return fmt.Errorf("%w: %s to move but already has more marks", ErrInvalidMarkCount, g.CurrentPlayer.Name())
+	}
+	if g.State != InProgress && mine < theirs {
+		return fmt.Errorf("%w: %s moved last but has fewer marks", ErrInvalidMarkCount, g.CurrentPlayer.Name())
+	}
+	return nil
+}
+
+// validateState checks State against the configured CheckWin, Outcome and CheckDraw
+func validateState(g Game) error {
+	if g.Board.Width() != g.Config.normalized().Width || g.Board.Height() != g.Config.normalized().Height {
+		return fmt.Errorf("%w: board is %dx%d but configuration is %s",
+			ErrInconsistentState, g.Board.Width(), g.Board.Height(), g.Config)
+	}
+
+	want, liner := InProgress, Player1
+	switch xLine, oLine := g.Config.CheckWin(g.Board, X), g.Config.CheckWin(g.Board, O); {
+	case xLine && oLine:
+		return fmt.Errorf("%w: both players have a winning line", ErrInconsistentState)
+	case (xLine || oLine) && g.Config.Variant == Wild:
+		// A line of either mark counts for the player who completed it
+		want, liner = g.Config.Outcome(g.CurrentPlayer), g.CurrentPlayer
+	case xLine:
+		want = g.Config.Outcome(Player1)
+	case oLine:
+		want, liner = g.Config.Outcome(Player2), Player2
+	case g.Config.CheckDraw(g.Board):
+		want = Draw
+	}
+
+	if g.State != want {
+		return fmt.Errorf("%w: state is %s but board shows %s", ErrInconsistentState, stateNames[g.State], stateNames[want])
+	}
+	if want != InProgress && want != Draw && g.CurrentPlayer != liner {
+		return fmt.Errorf("%w: the line was not completed by the last move", ErrInconsistentState)
+	}
+	return nil
+}
+
+// validateHistory checks that replaying History from the position before it
+// reproduces every recorded move and the final board and state
+func validateHistory(g Game) error {
+	if len(g.History) > g.MoveCount {
+		return fmt.Errorf("%w: %d moves recorded but move count is %d", ErrInvalidHistory, len(g.History), g.MoveCount)
+	}
+
+	// Rewind to the position before the first recorded move
+	// Each move must be on the board and still hold its mark, or Undo would
+	// index outside the board or clear a cell the move never filled
+	replay := g
+	for replay.CanUndo() {
+		last := replay.History[len(replay.History)-1]
+		if !replay.Board.InBounds(last.Row, last.Col) || replay.Board.GetCell(last.Row, last.Col) != last.Mark {
+			return fmt.Errorf("%w: move %d at (%d, %d) does not match the board",
+				ErrInvalidHistory, len(replay.History), last.Row, last.Col)
+		}
+		replay, _ = replay.Undo()
+	}
+	replay.Undone = nil
+
+	for i, want := range g.History {
+		if replay.CurrentPlayer != want.Player || replay.State != InProgress {
+			return fmt.Errorf("%w: move %d is out of turn", ErrInvalidHistory, i+1)
+		}
+		next, err := replay.MakeMoveMark(want.Row, want.Col, want.Mark)
+		if err != nil {
+			return fmt.Errorf("%w: move %d: %v", ErrInvalidHistory, i+1, err)
+		}
+		if next.State != want.State {
+			return fmt.Errorf("%w: move %d records the wrong state", ErrInvalidHistory, i+1)
+		}
+		replay = next
+	}
+
+	if !replay.Board.Equal(g.Board) || replay.State != g.State {
+		return fmt.Errorf("%w: replayed moves do not reach the saved board", ErrInvalidHistory)
+	}
+	return nil
+}
+
+// countMarks returns the number of cells holding mark
+func countMarks(board Board, mark Cell) int {
+	count := 0
+	for _, cells := range board {
+		for _, cell := range cells {
+			if cell == mark {
+				count++
+			}
+		}
+	}
+	return count
+}
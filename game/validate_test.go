@@ -0,0 +1,122 @@
+package game
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestValidatePosition verifies legal and illegal positions are told apart
+func TestValidatePosition(t *testing.T) {
+	tests := []struct {
+		name    string
+		game    Game
+		wantErr error
+	}{
+		{
+			name:    "New game is valid",
+			game:    NewGame(),
+			wantErr: nil,
+		},
+		{
+			name: "O to move after X",
+			game: Game{
+				Board:         Board{{X, Empty, Empty}, {Empty, Empty, Empty}, {Empty, Empty, Empty}},
+				CurrentPlayer: Player2,
+				MoveCount:     1,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "X to move with an extra X",
+			game: Game{
+				Board:         Board{{X, Empty, Empty}, {Empty, Empty, Empty}, {Empty, Empty, Empty}},
+				CurrentPlayer: Player1,
+				MoveCount:     1,
+			},
+			wantErr: ErrInvalidMarkCount,
+		},
+		{
+			name: "Two extra marks",
+			game: Game{
+				Board:         Board{{X, X, Empty}, {Empty, Empty, Empty}, {Empty, Empty, Empty}},
+				CurrentPlayer: Player2,
+				MoveCount:     2,
+			},
+			wantErr: ErrInvalidMarkCount,
+		},
+		{
+			name: "Move count disagrees with board",
+			game: Game{
+				Board:         Board{{X, Empty, Empty}, {Empty, Empty, Empty}, {Empty, Empty, Empty}},
+				CurrentPlayer: Player2,
+				MoveCount:     3,
+			},
+			wantErr: ErrInvalidMarkCount,
+		},
+		{
+			name: "X win recorded",
+			game: Game{
+				Board:         Board{{X, X, X}, {O, O, Empty}, {Empty, Empty, Empty}},
+				CurrentPlayer: Player1,
+				State:         Player1Won,
+				MoveCount:     5,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Wrong winner recorded",
+			game: Game{
+				Board:         Board{{X, X, X}, {O, O, Empty}, {Empty, Empty, Empty}},
+				CurrentPlayer: Player1,
+				State:         Player2Won,
+				MoveCount:     5,
+			},
+			wantErr: ErrInconsistentState,
+		},
+		{
+			name: "Both players have lines",
+			game: Game{
+				Board:         Board{{X, X, X}, {O, O, O}, {Empty, Empty, Empty}},
+				CurrentPlayer: Player2,
+				State:         Player2Won,
+				MoveCount:     6,
+			},
+			wantErr: ErrInconsistentState,
+		},
+		{
+			name: "Draw not recorded",
+			game: Game{
+				Board:         Board{{X, O, X}, {O, X, O}, {O, X, O}},
+				CurrentPlayer: Player1,
+				State:         InProgress,
+				MoveCount:     9,
+			},
+			wantErr: ErrInconsistentState,
+		},
+		{
+			name: "Draw recorded",
+			game: Game{
+				Board:         Board{{X, O, X}, {X, O, O}, {O, X, X}},
+				CurrentPlayer: Player1,
+				State:         Draw,
+				MoveCount:     9,
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidatePosition(tt.game)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("ValidatePosition() unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ValidatePosition() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Variant selects the rule that decides who a completed line counts for
+// The zero value is Standard
+type Variant int
+
+const (
+	// Standard rules: completing a line wins
+	Standard Variant = iota
+	// Misere rules: completing a line loses, so the opponent wins
+	Misere
+	// Wild rules: each move places either X or O, and whoever completes a
+	// line of either mark wins
+	Wild
+)
+
+// ErrUnknownVariant indicates a variant name that is not recognised
+var ErrUnknownVariant = &GameError{"Unknown variant. Use standard, misere or wild"}
+
+// String returns the variant's name: "standard", "misere" or "wild"
+func (v Variant) String() string {
+	switch v {
+	case Standard:
+		return "standard"
+	case Misere:
+		return "misere"
+	case Wild:
+		return "wild"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseVariant converts a variant name into a Variant, ignoring case
+// "misère" is accepted as well as "misere"
+func ParseVariant(name string) (Variant, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "standard":
+		return Standard, nil
+	case "misere", "misère":
+		return Misere, nil
+	case "wild":
+		return Wild, nil
+	default:
+		return Standard, fmt.Errorf("%w: got %q", ErrUnknownVariant, name)
+	}
+}
+
+// Set parses name into v so a Variant can be used as a command-line flag
+func (v *Variant) Set(name string) error {
+	parsed, err := ParseVariant(name)
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// Valid returns true if v is a known variant
+func (v Variant) Valid() bool {
+	return v == Standard || v == Misere || v == Wild
+}
@@ -0,0 +1,179 @@
+package game
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestParseVariant verifies variant names are recognised and unknown names rejected
+func TestParseVariant(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Variant
+		wantErr error
+	}{
+		{"standard", Standard, nil},
+		{"misere", Misere, nil},
+		{" Misère ", Misere, nil},
+		{"MISERE", Misere, nil},
+		{"Wild", Wild, nil},
+		{"reverse", Standard, ErrUnknownVariant},
+		{"", Standard, ErrUnknownVariant},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseVariant(tt.input)
+			if !errors.Is(err, tt.wantErr) || got != tt.want {
+				t.Errorf("ParseVariant(%q) = %v, %v; want %v, %v", tt.input, got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestConfigOutcome verifies who a completed line counts for under each variant
+func TestConfigOutcome(t *testing.T) {
+	tests := []struct {
+		variant Variant
+		player  Player
+		want    GameState
+	}{
+		{Standard, Player1, Player1Won},
+		{Standard, Player2, Player2Won},
+		{Misere, Player1, Player2Won},
+		{Misere, Player2, Player1Won},
+		{Wild, Player1, Player1Won},
+		{Wild, Player2, Player2Won},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.variant.String()+"/"+tt.player.Name(), func(t *testing.T) {
+			if got := (Config{Variant: tt.variant}).Outcome(tt.player); got != tt.want {
+				t.Errorf("Outcome(%v) = %v, want %v", tt.player, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestMakeMoveMisere verifies completing a line under misere rules loses
+func TestMakeMoveMisere(t *testing.T) {
+	g, err := NewGameWithConfig(Config{Width: 3, Height: 3, WinLength: 3, Variant: Misere})
+	if err != nil {
+		t.Fatalf("NewGameWithConfig() returned error: %v", err)
+	}
+	for _, m := range [][2]int{{0, 0}, {1, 0}, {0, 1}, {1, 1}, {0, 2}} {
+		if g, err = g.MakeMove(m[0], m[1]); err != nil {
+			t.Fatalf("MakeMove(%d, %d) returned error: %v", m[0], m[1], err)
+		}
+	}
+
+	if g.State != Player2Won {
+		t.Errorf("State = %v, want Player2Won after X completes the top row", g.State)
+	}
+	if g.CurrentPlayer != Player1 {
+		t.Errorf("CurrentPlayer = %v, want the last mover X", g.CurrentPlayer)
+	}
+	if err := ValidatePosition(g); err != nil {
+		t.Errorf("ValidatePosition() returned error: %v", err)
+	}
+	if err := validateHistory(g); err != nil {
+		t.Errorf("validateHistory() returned error: %v", err)
+	}
+}
+
+// TestMakeMoveMarkWild verifies wild moves place either mark and the mover
+// wins with a line of either mark
+func TestMakeMoveMarkWild(t *testing.T) {
+	wild := Config{Width: 3, Height: 3, WinLength: 3, Variant: Wild}
+	tests := []struct {
+		name      string
+		config    Config
+		moves     []Move
+		wantState GameState
+		wantErr   error
+	}{
+		{"X completes a line of O", wild, []Move{
+			{Row: 0, Col: 0, Mark: O}, {Row: 1, Col: 1, Mark: X}, {Row: 0, Col: 1, Mark: O}, {Row: 2, Col: 2, Mark: X}, {Row: 0, Col: 2, Mark: O},
+		}, Player1Won, nil},
+		{"O completes a line of X", wild, []Move{
+			{Row: 0, Col: 0, Mark: X}, {Row: 1, Col: 1, Mark: O}, {Row: 1, Col: 0, Mark: X}, {Row: 2, Col: 0, Mark: X},
+		}, Player2Won, nil},
+		{"Both players may place X", wild, []Move{
+			{Row: 0, Col: 0, Mark: X}, {Row: 0, Col: 1, Mark: X},
+		}, InProgress, nil},
+		{"Empty is not a mark", wild, []Move{{Row: 0, Col: 0, Mark: Empty}}, InProgress, ErrWrongMark},
+		{"Standard rejects the opponent's mark", StandardConfig(), []Move{{Row: 0, Col: 0, Mark: O}}, InProgress, ErrWrongMark},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := NewGameWithConfig(tt.config)
+			if err != nil {
+				t.Fatalf("NewGameWithConfig() returned error: %v", err)
+			}
+			for _, m := range tt.moves {
+				if g, err = g.MakeMoveMark(m.Row, m.Col, m.Mark); err != nil {
+					break
+				}
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("MakeMoveMark() error = %v, want %v", err, tt.wantErr)
+			}
+			if g.State != tt.wantState {
+				t.Errorf("State = %v, want %v", g.State, tt.wantState)
+			}
+			if err := ValidatePosition(g); err != nil {
+				t.Errorf("ValidatePosition() returned error: %v", err)
+			}
+			if err := validateHistory(g); err != nil {
+				t.Errorf("validateHistory() returned error: %v", err)
+			}
+		})
+	}
+}
+
+// TestWildRoundTrip verifies wild games keep their marks through save, load,
+// position strings, undo and redo
+func TestWildRoundTrip(t *testing.T) {
+	g, _ := NewGameWithConfig(Config{Width: 3, Height: 3, WinLength: 3, Variant: Wild})
+	for _, m := range []Move{{Row: 0, Col: 0, Mark: O}, {Row: 1, Col: 1, Mark: O}, {Row: 2, Col: 2, Mark: X}} {
+		var err error
+		if g, err = g.MakeMoveMark(m.Row, m.Col, m.Mark); err != nil {
+			t.Fatalf("MakeMoveMark() returned error: %v", err)
+		}
+	}
+
+	data, err := g.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() returned error: %v", err)
+	}
+	var loaded Game
+	if err := loaded.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON() returned error: %v", err)
+	}
+	if len(loaded.History) != 3 || loaded.History[0].Mark != O || loaded.History[2].Mark != X {
+		t.Errorf("Loaded history = %+v, want the marks O, O, X", loaded.History)
+	}
+
+	parsed, err := ParsePosition(g.String())
+	if err != nil || !parsed.Board.Equal(g.Board) || parsed.CurrentPlayer != Player2 {
+		t.Errorf("ParsePosition(%q) = %v, %v; want the same board with O to move", g.String(), parsed, err)
+	}
+
+	undone, _ := loaded.Undo()
+	redone, err := undone.Redo()
+	if err != nil || redone.Board.GetCell(2, 2) != X {
+		t.Errorf("Redo() = %v, %v; want X back at (2, 2)", redone.Board, err)
+	}
+}
+
+// TestVariantSet verifies a Variant works as a command-line flag value
+func TestVariantSet(t *testing.T) {
+	var v Variant
+	if err := v.Set("misere"); err != nil || v != Misere {
+		t.Errorf("Set(misere) = %v, %v; want Misere", v, err)
+	}
+	if err := v.Set("bogus"); !errors.Is(err, ErrUnknownVariant) || v != Misere {
+		t.Errorf("Set(bogus) = %v, %v; want ErrUnknownVariant and no change", v, err)
+	}
+}
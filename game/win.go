@@ -1,55 +1,95 @@
 package game
 
+// Position identifies a cell on the board
+type Position struct {
+	Row int
+	Col int
+}
+
 // CheckWin determines if the specified player has won the game
 // by achieving three marks in a row (horizontal, vertical, or diagonal)
-// Complexity: O(1) - fixed 8 checks regardless of board size
+// Boards of any size are supported; use CheckWinLength for other line lengths
 func CheckWin(board Board, player Cell) bool {
-	return checkRows(board, player) ||
-		checkColumns(board, player) ||
-		checkDiagonals(board, player)
+	return CheckWinLength(board, player, DefaultWinLength)
+}
+
+// CheckWinLength determines if the specified player has k marks in a row
+// Complexity: O(width * height * k)
+func CheckWinLength(board Board, player Cell, k int) bool {
+	return checkRows(board, player, k) ||
+		checkColumns(board, player, k) ||
+		checkDiagonals(board, player, k)
+}
+
+// checkRows checks if player has k in a row in any row
+func checkRows(board Board, player Cell, k int) bool {
+	return hasRun(board, player, k, 0, 1)
+}
+
+// checkColumns checks if player has k in a row in any column
+func checkColumns(board Board, player Cell, k int) bool {
+	return hasRun(board, player, k, 1, 0)
 }
 
-// checkRows checks if player has won in any row
-func checkRows(board Board, player Cell) bool {
-	for row := 0; row < BOARD_SIZE; row++ {
-		if board[row][0] == player &&
-			board[row][1] == player &&
-			board[row][2] == player {
-			return true
+// checkDiagonals checks if player has k in a row along either diagonal direction
+func checkDiagonals(board Board, player Cell, k int) bool {
+	// Main diagonals (top-left to bottom-right) and anti-diagonals (top-right to bottom-left)
+	return hasRun(board, player, k, 1, 1) || hasRun(board, player, k, 1, -1)
+}
+
+// hasRun checks for k consecutive player marks stepping by (dRow, dCol)
+func hasRun(board Board, player Cell, k, dRow, dCol int) bool {
+	for row := range board {
+		for col := range board[row] {
+			if isRun(board, player, Position{row, col}, k, dRow, dCol) {
+				return true
+			}
 		}
 	}
 	return false
 }
 
-// checkColumns checks if player has won in any column
-func checkColumns(board Board, player Cell) bool {
-	for col := 0; col < BOARD_SIZE; col++ {
-		if board[0][col] == player &&
-			board[1][col] == player &&
-			board[2][col] == player {
-			return true
+// isRun checks for k consecutive player marks starting at start
+func isRun(board Board, player Cell, start Position, k, dRow, dCol int) bool {
+	for i := 0; i < k; i++ {
+		row, col := start.Row+i*dRow, start.Col+i*dCol
+		if !board.InBounds(row, col) || board[row][col] != player {
+			return false
 		}
 	}
-	return false
+	return true
 }
 
-// checkDiagonals checks if player has won in either diagonal
-func checkDiagonals(board Board, player Cell) bool {
-	// Main diagonal (top-left to bottom-right)
-	mainDiag := board[0][0] == player &&
-		board[1][1] == player &&
-		board[2][2] == player
-
-	// Anti-diagonal (top-right to bottom-left)
-	antiDiag := board[0][2] == player &&
-		board[1][1] == player &&
-		board[2][0] == player
+// Lines returns every run of WinLength cells on the configured board
+// (horizontal, vertical, and both diagonal directions)
+func (c Config) Lines() [][]Position {
+	c = c.normalized()
+	board := NewBoardSize(c.Width, c.Height)
+	directions := [][2]int{{0, 1}, {1, 0}, {1, 1}, {1, -1}}
+	if c.WinLength == 1 {
+		directions = directions[:1] // Every direction yields the same single cells
+	}
 
-	return mainDiag || antiDiag
+	var lines [][]Position
+	for _, d := range directions {
+		for row := 0; row < c.Height; row++ {
+			for col := 0; col < c.Width; col++ {
+				if !board.InBounds(row+(c.WinLength-1)*d[0], col+(c.WinLength-1)*d[1]) {
+					continue
+				}
+				line := make([]Position, c.WinLength)
+				for i := range line {
+					line[i] = Position{row + i*d[0], col + i*d[1]}
+				}
+				lines = append(lines, line)
+			}
+		}
+	}
+	return lines
 }
 
 // CheckDraw determines if the game is a draw
-// A draw occurs when the board is full and neither player has won
+// A draw occurs when the board is full and neither player has three in a row
 func CheckDraw(board Board) bool {
 	// Not a draw if board isn't full
 	if !board.IsFull() {
@@ -325,3 +325,50 @@ func TestCheckDrawWithWinner(t *testing.T) {
 		})
 	}
 }
+
+// TestCheckWinLength verifies k-in-a-row detection on larger boards
+func TestCheckWinLength(t *testing.T) {
+	board := NewBoardSize(6, 5)
+	for i := 0; i < 4; i++ {
+		board = board.SetCell(i+1, 4-i, O) // Anti-diagonal from (1,4) to (4,1)
+	}
+	board = board.SetCell(4, 0, X).SetCell(4, 2, X).SetCell(4, 3, X).SetCell(4, 5, X)
+
+	tests := []struct {
+		name   string
+		player Cell
+		k      int
+		want   bool
+	}{
+		{"Four on the anti-diagonal", O, 4, true},
+		{"Five is not reached", O, 5, false},
+		{"Broken row of four X", X, 3, false},
+		{"Two in a row in the bottom row", X, 2, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CheckWinLength(board, tt.player, tt.k); got != tt.want {
+				t.Errorf("CheckWinLength(%v, %d) = %v, want %v", tt.player, tt.k, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestCheckDrawConfig verifies draws use the configured win length
+func TestCheckDrawConfig(t *testing.T) {
+	// X has three in a row, which only wins when WinLength is 3
+	board := Board{
+		{X, X, X, O},
+		{O, O, X, X},
+		{X, X, O, O},
+		{O, O, X, O},
+	}
+
+	if (Config{Width: 4, Height: 4, WinLength: 3}).CheckDraw(board) {
+		t.Error("CheckDraw() with WinLength 3 = true, want false")
+	}
+	if !(Config{Width: 4, Height: 4, WinLength: 4}).CheckDraw(board) {
+		t.Error("CheckDraw() with WinLength 4 = false, want true")
+	}
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+	"github.com/YOUR_USERNAME/tictactoe/game/solver"
+)
+
+// highlightMark marks the suggested cell when a hint is displayed
+const highlightMark = "*"
+
+// showHint prints the solver's suggested move for g.CurrentPlayer on a
+// board with the suggested cell highlighted, followed by the reason
+func showHint(g game.Game) {
+	hint, ok, err := solver.New().Hint(g)
+	if err != nil {
+		displayError(err)
+		return
+	}
+	if !ok {
+		return
+	}
+
+	displayBoardHighlight(g.Board, &hint.Position)
+	fmt.Printf("Hint: play %d %d (%s). %s\n", hint.Row, hint.Col, highlightMark, explainHint(g.CurrentPlayer, hint))
+}
+
+// explainHint describes why hint is the best move for player
+func explainHint(player game.Player, hint solver.Hint) string {
+	opponent := player.Other().Name()
+	var why string
+	switch hint.Reason {
+	case solver.WinNow:
+		return "It completes a line and wins."
+	case solver.Block:
+		why = fmt.Sprintf("It blocks %s from completing a line.", opponent)
+	case solver.Fork:
+		why = fmt.Sprintf("It creates two threats at once and %s cannot block both.", opponent)
+	case solver.ForcedWin:
+		why = "It keeps a forced win."
+	case solver.ForcedDraw:
+		return "Neither side can force a win, and this move keeps the draw."
+	case solver.BestDefence:
+		return fmt.Sprintf("Every move loses against best play; this one holds out longest, %s.",
+			describeOutcome(player, hint.Value, hint.Distance))
+	}
+
+	switch hint.Value {
+	case solver.Win:
+		return fmt.Sprintf("%s With best play %s.", why, describeOutcome(player, hint.Value, hint.Distance))
+	case solver.Loss:
+		return fmt.Sprintf("%s It is still lost against best play: %s.", why, describeOutcome(player, hint.Value, hint.Distance))
+	default:
+		return fmt.Sprintf("%s With best play the game is a draw.", why)
+	}
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+	"github.com/YOUR_USERNAME/tictactoe/game/solver"
+)
+
+// TestHandleCommandHint verifies hint is a command that leaves the game unchanged
+func TestHandleCommandHint(t *testing.T) {
+	tests := []struct {
+		name     string
+		position string
+	}{
+		{"Mid-game", "XX./.O./... o"},
+		{"Finished game", "XXX/OO./... o"},
+		{"Too large to solve", "..../..../..../.... x"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := game.ParsePosition(tt.position)
+			if err != nil {
+				t.Fatalf("ParsePosition() returned error: %v", err)
+			}
+			got, ok := handleCommand(g, "hint", opponent{}, false)
+			if !ok {
+				t.Fatal("handleCommand(hint) was not treated as a command")
+			}
+			if got.String() != g.String() {
+				t.Errorf("handleCommand(hint) changed the game to %q", got)
+			}
+		})
+	}
+}
+
+// TestExplainHint verifies each reason is explained in terms of the players
+func TestExplainHint(t *testing.T) {
+	tests := []struct {
+		name string
+		hint solver.Hint
+		want string
+	}{
+		{"Win now", solver.Hint{MoveResult: solver.MoveResult{Value: solver.Win, Distance: 1}, Reason: solver.WinNow},
+			"It completes a line and wins."},
+		{"Block", solver.Hint{MoveResult: solver.MoveResult{Value: solver.Draw, Distance: 6}, Reason: solver.Block},
+			"It blocks Player 2 (O) from completing a line. With best play the game is a draw."},
+		{"Block but lost", solver.Hint{MoveResult: solver.MoveResult{Value: solver.Loss, Distance: 2}, Reason: solver.Block},
+			"still lost against best play: Player 2 (O) wins in 2 plies."},
+		{"Fork", solver.Hint{MoveResult: solver.MoveResult{Value: solver.Win, Distance: 3}, Reason: solver.Fork},
+			"cannot block both. With best play Player 1 (X) wins in 3 plies."},
+		{"Forced draw", solver.Hint{MoveResult: solver.MoveResult{Value: solver.Draw, Distance: 9}, Reason: solver.ForcedDraw},
+			"Neither side can force a win"},
+		{"Best defence", solver.Hint{MoveResult: solver.MoveResult{Value: solver.Loss, Distance: 4}, Reason: solver.BestDefence},
+			"holds out longest, Player 2 (O) wins in 4 plies."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := explainHint(game.Player1, tt.hint); !strings.Contains(got, tt.want) {
+				t.Errorf("explainHint() = %q, want it to contain %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/YOUR_USERNAME/tictactoe/api"
+)
+
+// defaultHTTPAddr is the address `tictactoe http` listens on by default
+const defaultHTTPAddr = ":8080"
+
+// runHTTP implements `tictactoe http`: serve the REST API until interrupted
+func runHTTP(args []string) error {
+	fs := flag.NewFlagSet("http", flag.ContinueOnError)
+	addr := fs.String("addr", defaultHTTPAddr, "address to listen on")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	ln, err := net.Listen("tcp", *addr)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Serving the tic-tac-toe API on http://%s\n", ln.Addr())
+	return newHTTPServer().Serve(ln)
+}
+
+// newHTTPServer returns an HTTP server for the REST API backed by a fresh store
+func newHTTPServer() *http.Server {
+	return &http.Server{
+		Handler:           api.NewHandler(api.NewStore()),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"net"
+	"net/http"
+	"testing"
+)
+
+// TestHTTPServer verifies the http subcommand serves the REST API
+func TestHTTPServer(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() returned error: %v", err)
+	}
+	server := newHTTPServer()
+	go server.Serve(ln)
+	defer server.Close()
+
+	resp, err := http.Post("http://"+ln.Addr().String()+"/games", "application/json", nil)
+	if err != nil {
+		t.Fatalf("POST /games returned error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("POST /games status = %d, want %d", resp.StatusCode, http.StatusCreated)
+	}
+}
@@ -1,9 +1,15 @@
 package main
 
 import (
+	"bufio"
+	"errors"
+	"flag"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/YOUR_USERNAME/tictactoe/game"
+	"github.com/YOUR_USERNAME/tictactoe/game/ai"
 )
 
 // TestCompleteGameWithWinner simulates a complete game ending in a win
@@ -238,3 +244,442 @@ func TestMultipleInvalidInputsBeforeValid(t *testing.T) {
 		t.Error("Player should switch to Player2 after valid move")
 	}
 }
+
+// TestParseComputerPlayer verifies the -ai flag selects the engine's side
+func TestParseComputerPlayer(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      string
+		want       game.Player
+		vsComputer bool
+		wantError  bool
+	}{
+		{"Two-player mode", "", game.Player1, false, false},
+		{"Computer plays X", "X", game.Player1, true, false},
+		{"Computer plays O lowercase", "o", game.Player2, true, false},
+		{"Unknown side rejected", "Z", game.Player1, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, vsComputer, err := parseComputerPlayer(tt.value)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("parseComputerPlayer(%q) error = %v, wantError %v", tt.value, err, tt.wantError)
+			}
+			if got != tt.want || vsComputer != tt.vsComputer {
+				t.Errorf("parseComputerPlayer(%q) = (%v, %v), want (%v, %v)",
+					tt.value, got, vsComputer, tt.want, tt.vsComputer)
+			}
+		})
+	}
+}
+
+// TestComputerNeverLosesToScriptedPlayer plays a full game against the engine
+func TestComputerNeverLosesToScriptedPlayer(t *testing.T) {
+	g := game.NewGame()
+	human := [][2]int{{0, 0}, {2, 2}, {0, 2}, {2, 0}, {1, 0}, {0, 1}, {1, 2}, {2, 1}}
+
+	for g.State == game.InProgress {
+		if g.CurrentPlayer == game.Player2 {
+			g = playComputerMove(g, ai.PerfectStrategy{})
+			continue
+		}
+		for _, move := range human {
+			if next, err := g.MakeMove(move[0], move[1]); err == nil {
+				g = next
+				break
+			}
+		}
+	}
+
+	if g.State == game.Player1Won {
+		t.Errorf("Computer lost to scripted player, board %v", g.Board)
+	}
+}
+
+// TestNewStrategy verifies the -difficulty and -epsilon flags are validated
+func TestNewStrategy(t *testing.T) {
+	tests := []struct {
+		name       string
+		difficulty string
+		epsilon    float64
+		wantError  error
+	}{
+		{"Perfect without blunders", "perfect", 0, nil},
+		{"Greedy with blunders", "greedy", 0.25, nil},
+		{"Unknown difficulty", "grandmaster", 0, ai.ErrUnknownDifficulty},
+		{"Negative epsilon", "perfect", -0.1, ai.ErrInvalidEpsilon},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			strategy, err := newStrategy(tt.difficulty, tt.epsilon, game.StandardConfig(), 1)
+			if tt.wantError != nil {
+				if !errors.Is(err, tt.wantError) {
+					t.Errorf("newStrategy() error = %v, want %v", err, tt.wantError)
+				}
+				return
+			}
+			if err != nil || strategy == nil {
+				t.Errorf("newStrategy() = (%v, %v), want a strategy", strategy, err)
+			}
+		})
+	}
+}
+
+// TestHandleCommandUndoRedo verifies undo and redo at the prompt in two-player mode
+func TestHandleCommandUndoRedo(t *testing.T) {
+	g := game.NewGame()
+	g, _ = g.MakeMove(1, 1)
+	g, _ = g.MakeMove(0, 0)
+
+	undone, ok := handleCommand(g, "undo", opponent{}, false)
+	if !ok {
+		t.Fatal("handleCommand(\"undo\") was not treated as a command")
+	}
+	if undone.MoveCount != 1 || undone.CurrentPlayer != game.Player2 {
+		t.Errorf("After undo: MoveCount = %d, CurrentPlayer = %v, want 1 and Player2",
+			undone.MoveCount, undone.CurrentPlayer)
+	}
+
+	redone, ok := handleCommand(undone, " REDO ", opponent{}, false)
+	if !ok {
+		t.Fatal("handleCommand(\"REDO\") was not treated as a command")
+	}
+	if redone.MoveCount != 2 || redone.Board.GetCell(0, 0) != game.O {
+		t.Errorf("After redo: MoveCount = %d, want 2 with O at (0,0)", redone.MoveCount)
+	}
+
+	if _, ok := handleCommand(g, "1 2", opponent{}, false); ok {
+		t.Error("handleCommand(\"1 2\") should not be treated as a command")
+	}
+}
+
+// TestHandleCommandSkipsComputerMoves verifies undo takes back the computer's reply too
+func TestHandleCommandSkipsComputerMoves(t *testing.T) {
+	computer := opponent{enabled: true, player: game.Player2, strategy: ai.PerfectStrategy{}}
+	g := game.NewGame()
+	g, _ = g.MakeMove(1, 1)
+	g, _ = g.MakeMove(0, 0)
+
+	undone, _ := handleCommand(g, "undo", computer, false)
+	if undone.MoveCount != 0 || undone.CurrentPlayer != game.Player1 {
+		t.Errorf("After undo: MoveCount = %d, CurrentPlayer = %v, want 0 and Player1",
+			undone.MoveCount, undone.CurrentPlayer)
+	}
+
+	redone, _ := handleCommand(undone, "redo", computer, false)
+	if redone.MoveCount != 2 || redone.CurrentPlayer != game.Player1 {
+		t.Errorf("After redo: MoveCount = %d, CurrentPlayer = %v, want 2 and Player1",
+			redone.MoveCount, redone.CurrentPlayer)
+	}
+}
+
+// TestHandleCommandSaveLoad verifies games can be saved and loaded from the prompt
+func TestHandleCommandSaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "game.json")
+	g := game.NewGame()
+	g, _ = g.MakeMove(1, 1)
+
+	if _, ok := handleCommand(g, "save "+path, opponent{}, false); !ok {
+		t.Fatal("handleCommand(\"save\") was not treated as a command")
+	}
+
+	loaded, ok := handleCommand(game.NewGame(), "load "+path, opponent{}, false)
+	if !ok {
+		t.Fatal("handleCommand(\"load\") was not treated as a command")
+	}
+	if loaded.Board.GetCell(1, 1) != game.X || loaded.CurrentPlayer != game.Player2 {
+		t.Errorf("Loaded game = %+v, want X at (1,1) with Player2 to move", loaded)
+	}
+
+	// A failed load keeps the current game
+	kept, _ := handleCommand(g, "load "+filepath.Join(t.TempDir(), "missing.json"), opponent{}, false)
+	if kept.MoveCount != 1 {
+		t.Errorf("Failed load changed the game: MoveCount = %d, want 1", kept.MoveCount)
+	}
+}
+
+// TestHandleCommandLoadKeepsComputerConfig verifies a game on another board
+// cannot be loaded against a computer whose strategy was built for this one
+func TestHandleCommandLoadKeepsComputerConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "game.json")
+	saved, _ := game.NewGame().MakeMove(1, 1)
+	if err := saveFile(path, saved); err != nil {
+		t.Fatalf("saveFile() returned error: %v", err)
+	}
+
+	config := game.Config{Width: 5, Height: 5, WinLength: 4}
+	big, _ := game.NewGameWithConfig(config)
+	strategy, _ := newStrategy("heuristic", 0, config, 1)
+	computer := opponent{enabled: true, player: game.Player2, strategy: strategy}
+
+	kept, _ := handleCommand(big, "load "+path, computer, false)
+	if kept.Config.String() != "5x5/4" || kept.MoveCount != 0 {
+		t.Errorf("Loaded game = %v after %d moves, want the 5x5 game kept", kept.Config, kept.MoveCount)
+	}
+
+	// Without the computer any configuration may be loaded
+	loaded, _ := handleCommand(big, "load "+path, opponent{}, false)
+	if !loaded.Config.IsStandard() || loaded.MoveCount != 1 {
+		t.Errorf("Loaded game = %v after %d moves, want the 3x3 save", loaded.Config, loaded.MoveCount)
+	}
+}
+
+// TestSaveLoadNotation verifies .ttn files are written and read as game notation
+func TestSaveLoadNotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "game.ttn")
+	g := game.NewGame()
+	for _, m := range [][2]int{{1, 1}, {0, 0}, {2, 2}} {
+		g, _ = g.MakeMove(m[0], m[1])
+	}
+
+	if err := saveFile(path, g); err != nil {
+		t.Fatalf("saveFile() returned error: %v", err)
+	}
+	rec, err := readNotation(path)
+	if err != nil {
+		t.Fatalf("readNotation() returned error: %v", err)
+	}
+	if len(rec.Positions) != 4 {
+		t.Errorf("Notation has %d positions, want 4", len(rec.Positions))
+	}
+
+	loaded, err := loadFile(path)
+	if err != nil {
+		t.Fatalf("loadFile() returned error: %v", err)
+	}
+	if !loaded.Board.Equal(g.Board) || loaded.CurrentPlayer != g.CurrentPlayer || len(loaded.History) != 3 {
+		t.Errorf("Loaded game = %+v, want %+v", loaded, g)
+	}
+}
+
+// TestMovePrompt verifies the prompt reflects the board dimensions
+func TestMovePrompt(t *testing.T) {
+	tests := []struct {
+		name  string
+		board game.Board
+		want  string
+	}{
+		{"Classic board", game.NewBoard(), "Enter row and column (0-2), e.g., '1 1': "},
+		{"Gomoku board", game.NewBoardSize(15, 15), "Enter row and column (0-14), e.g., '7 7': "},
+		{"Rectangular board", game.NewBoardSize(7, 6), "Enter row (0-5) and column (0-6), e.g., '3 3': "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := movePrompt(tt.board); got != tt.want {
+				t.Errorf("movePrompt() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestNewGameFromFlags verifies the board flags and -load are honoured
+func TestNewGameFromFlags(t *testing.T) {
+	g, err := newGame(game.Config{Width: 15, Height: 15, WinLength: 5}, "", "")
+	if err != nil {
+		t.Fatalf("newGame() returned error: %v", err)
+	}
+	if g.Board.Width() != 15 || g.Config.WinLength != 5 {
+		t.Errorf("newGame() config = %v, want 15x15/5", g.Config)
+	}
+
+	if _, err := newGame(game.Config{Width: 3, Height: 3, WinLength: 7}, "", ""); !errors.Is(err, game.ErrInvalidConfig) {
+		t.Errorf("newGame(3x3/7) error = %v, want ErrInvalidConfig", err)
+	}
+}
+
+// TestNewGameFromPosition verifies -position sets up the board and overrides the board flags
+func TestNewGameFromPosition(t *testing.T) {
+	g, err := newGame(game.Config{Width: 15, Height: 15, WinLength: 5}, "", "X.O/.X./... o")
+	if err != nil {
+		t.Fatalf("newGame() returned error: %v", err)
+	}
+	if !g.Config.IsStandard() {
+		t.Errorf("newGame() config = %v, want 3x3/3", g.Config)
+	}
+	if g.Board.GetCell(1, 1) != game.X || g.CurrentPlayer != game.Player2 {
+		t.Errorf("newGame() = %q, want X.O/.X./... o", g.String())
+	}
+
+	if _, err := newGame(game.StandardConfig(), "", "XX./.../... o"); !errors.Is(err, game.ErrInvalidMarkCount) {
+		t.Errorf("newGame() with impossible position error = %v, want ErrInvalidMarkCount", err)
+	}
+	if _, err := newGame(game.StandardConfig(), "game.json", ".../.../... x"); !errors.Is(err, errStartFlags) {
+		t.Errorf("newGame() with -load and -position error = %v, want errStartFlags", err)
+	}
+}
+
+// TestNewGameVariant verifies -variant applies to new games and to positions that name no variant
+func TestNewGameVariant(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	config := boardFlags(fs)
+	if err := fs.Parse([]string{"-variant", "misere"}); err != nil {
+		t.Fatalf("Parse() returned error: %v", err)
+	}
+
+	g, err := newGame(config(), "", "")
+	if err != nil || g.Config.Variant != game.Misere {
+		t.Fatalf("newGame() = %v, %v; want a misere game", g.Config, err)
+	}
+
+	// X has completed the top row, which loses under misere rules
+	g, err = newGame(config(), "", "XXX/OO./... o")
+	if err != nil || g.State != game.Player2Won {
+		t.Errorf("newGame() with position = %v, %v; want O to have won", g.State, err)
+	}
+
+	if err := fs.Parse([]string{"-variant", "reverse"}); err == nil {
+		t.Error("Parse(-variant reverse) accepted an unknown variant")
+	}
+}
+
+// TestRunGameWild plays a scripted wild game where O completes a line of X
+// after recovering from malformed moves
+func TestRunGameWild(t *testing.T) {
+	g, err := game.NewGameWithConfig(game.Config{Width: 3, Height: 3, WinLength: 3, Variant: game.Wild})
+	if err != nil {
+		t.Fatalf("NewGameWithConfig() returned error: %v", err)
+	}
+
+	input := "1 1\nZ 1 1\nx 0 0\nO 1 1\nX 0 1\nX 0 2\n"
+	g, played := runGame(g, bufio.NewScanner(strings.NewReader(input)), opponent{}, false)
+
+	if g.State != game.Player2Won || !played {
+		t.Errorf("State = %v, want Player2Won after O completes the top row of X", g.State)
+	}
+	if g.MoveCount != 4 || g.Board.GetCell(1, 1) != game.O {
+		t.Errorf("Board = %q after %d moves, want O in the centre after 4", g.String(), g.MoveCount)
+	}
+}
+
+// TestHandleCommandLoadWildAgainstComputer verifies a wild game cannot be
+// loaded at the prompt while the computer plays
+func TestHandleCommandLoadWildAgainstComputer(t *testing.T) {
+	wild, _ := game.NewGameWithConfig(game.Config{Width: 3, Height: 3, WinLength: 3, Variant: game.Wild})
+	wild, _ = wild.MakeMoveMark(1, 1, game.O)
+	path := filepath.Join(t.TempDir(), "wild.json")
+	if err := saveFile(path, wild); err != nil {
+		t.Fatalf("saveFile() returned error: %v", err)
+	}
+
+	strategy, _ := newStrategy("perfect", 0, game.StandardConfig(), 1)
+	computer := opponent{enabled: true, player: game.Player2, strategy: strategy}
+	kept, _ := handleCommand(game.NewGame(), "load "+path, computer, false)
+	if kept.Config.Variant != game.Standard || kept.MoveCount != 0 {
+		t.Errorf("Loaded game = %v after %d moves, want the standard game kept", kept.Config, kept.MoveCount)
+	}
+
+	loaded, _ := handleCommand(game.NewGame(), "load "+path, opponent{}, false)
+	if loaded.Config.Variant != game.Wild || loaded.Board.GetCell(1, 1) != game.O {
+		t.Errorf("Loaded game = %q, want the wild save for two players", loaded.String())
+	}
+}
+
+// TestMarkedMovePrompt verifies the wild prompt asks for a mark
+func TestMarkedMovePrompt(t *testing.T) {
+	want := "Enter X or O, row (0-2) and column (0-2), e.g., 'X 1 1': "
+	if got := markedMovePrompt(game.NewBoard()); got != want {
+		t.Errorf("markedMovePrompt() = %q, want %q", got, want)
+	}
+}
+
+// TestCommandList verifies hint is offered only where the solver can search
+func TestCommandList(t *testing.T) {
+	tests := []struct {
+		variant game.Variant
+		want    string
+	}{
+		{game.Standard, "Commands: undo, redo, hint, save <file>, load <file>"},
+		{game.Misere, "Commands: undo, redo, hint, save <file>, load <file>"},
+		{game.Wild, "Commands: undo, redo, save <file>, load <file>"},
+	}
+	for _, tt := range tests {
+		config := game.StandardConfig()
+		config.Variant = tt.variant
+		if got := commandList(config); got != tt.want {
+			t.Errorf("commandList(%v) = %q, want %q", tt.variant, got, tt.want)
+		}
+	}
+}
+
+// TestPlayMatch plays a scripted best-of-3 match where the first mover wins each game
+func TestPlayMatch(t *testing.T) {
+	match, err := game.NewMatch(game.StandardConfig(), 3)
+	if err != nil {
+		t.Fatalf("NewMatch() returned error: %v", err)
+	}
+
+	// Each game the first mover takes the top row; Player 2 opens game 2
+	topRowWin := "0 0\n1 0\n0 1\n1 1\n0 2\n"
+	scanner := bufio.NewScanner(strings.NewReader(strings.Repeat(topRowWin, 3)))
+	match = playMatch(match, scanner, opponent{}, recorder{})
+
+	if !match.IsOver() || match.GamesPlayed() != 3 {
+		t.Fatalf("Match over = %v after %d games, want over after 3", match.IsOver(), match.GamesPlayed())
+	}
+	want := []game.GameState{game.Player1Won, game.Player2Won, game.Player1Won}
+	for i, result := range match.Results {
+		if result != want[i] {
+			t.Errorf("Game %d result = %v, want %v", i+1, result, want[i])
+		}
+	}
+	if winner, decided := match.Winner(); !decided || winner != game.Player1 {
+		t.Errorf("Winner() = %v, %v; want Player1, true", winner, decided)
+	}
+}
+
+// TestPlayMatchRefusesLoad verifies saves loaded mid-match neither score a
+// result nor end the series
+func TestPlayMatchRefusesLoad(t *testing.T) {
+	dir := t.TempDir()
+	oWon, _ := game.NewGame().MakeMove(0, 0)
+	for _, m := range [][2]int{{1, 0}, {2, 2}, {1, 1}, {0, 2}, {1, 2}} {
+		oWon, _ = oWon.MakeMove(m[0], m[1])
+	}
+	big, _ := game.NewGameWithConfig(game.Config{Width: 4, Height: 4, WinLength: 3})
+
+	tests := []struct {
+		name string
+		save game.Game
+	}{
+		{"Finished save", oWon},
+		{"Different board", big},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, strings.ReplaceAll(tt.name, " ", "-")+".json")
+			if err := saveFile(path, tt.save); err != nil {
+				t.Fatalf("saveFile() returned error: %v", err)
+			}
+
+			match, _ := game.NewMatch(game.StandardConfig(), 3)
+			topRowWin := "0 0\n1 0\n0 1\n1 1\n0 2\n"
+			scanner := bufio.NewScanner(strings.NewReader("load " + path + "\n" + strings.Repeat(topRowWin, 3)))
+			match = playMatch(match, scanner, opponent{}, recorder{})
+
+			want := []game.GameState{game.Player1Won, game.Player2Won, game.Player1Won}
+			if !match.IsOver() || len(match.Results) != len(want) {
+				t.Fatalf("Match results = %v, want %v", match.Results, want)
+			}
+			for i, result := range match.Results {
+				if result != want[i] {
+					t.Errorf("Game %d result = %v, want %v", i+1, result, want[i])
+				}
+			}
+		})
+	}
+}
+
+// TestPlayMatchStopsWhenInputEnds verifies an unfinished game is not recorded
+func TestPlayMatchStopsWhenInputEnds(t *testing.T) {
+	match, _ := game.NewMatch(game.StandardConfig(), 3)
+	scanner := bufio.NewScanner(strings.NewReader("0 0\n1 0\n0 1\n1 1\n0 2\n1 1\n"))
+	match = playMatch(match, scanner, opponent{}, recorder{})
+
+	if match.GamesPlayed() != 1 || match.IsOver() {
+		t.Errorf("GamesPlayed() = %d, IsOver() = %v; want 1 game and an undecided match", match.GamesPlayed(), match.IsOver())
+	}
+}
@@ -3,27 +3,246 @@ package main
 import (
 	"bufio"
 	"errors"
+	"flag"
 	"fmt"
+	"math/rand/v2"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/YOUR_USERNAME/tictactoe/game"
+	"github.com/YOUR_USERNAME/tictactoe/game/ai"
+	"github.com/YOUR_USERNAME/tictactoe/game/qubic"
+	"github.com/YOUR_USERNAME/tictactoe/game/solver"
+	"github.com/YOUR_USERNAME/tictactoe/notation"
+	"github.com/YOUR_USERNAME/tictactoe/tui"
 	"github.com/YOUR_USERNAME/tictactoe/validation"
 )
 
+// Command-line and prompt errors
+var (
+	// errUnknownComputer indicates the -ai flag names neither X nor O
+	errUnknownComputer = errors.New("invalid -ai value: use X or O")
+
+	// errMissingFile indicates save or load was typed without exactly one file name
+	errMissingFile = errors.New("please name one file, e.g. 'save game.json'")
+
+	// errStatsArgs indicates stats was given more than one player name
+	errStatsArgs = errors.New("usage: tictactoe stats [name]")
+
+	// errMatchFlags indicates -best-of was combined with a flag that plays a single game
+	errMatchFlags = errors.New("-best-of cannot be combined with -load, -position or -tui")
+
+	// errMatchLoad indicates load was used during a match, whose games must be played in full
+	errMatchLoad = errors.New("cannot load a game during a -best-of match; finish or quit the match first")
+
+	// errLoadConfig indicates a game on another board was loaded against the
+	// computer, whose strategy was built for the current board
+	errLoadConfig = errors.New("cannot load a game with a different board or variant while playing the computer")
+
+	// errStartFlags indicates both -load and -position were given
+	errStartFlags = errors.New("-load cannot be combined with -position")
+
+	// errWildFlags indicates a wild game was combined with a flag whose player
+	// can only place its own mark
+	errWildFlags = errors.New("the wild variant cannot be combined with -ai or -tui")
+)
+
+// opponent describes the computer player in single-player mode
+type opponent struct {
+	enabled  bool        // Whether the computer plays at all
+	player   game.Player // Side the computer plays
+	strategy ai.Strategy // How the computer chooses its moves
+}
+
+// controls returns true if the computer plays for p
+func (o opponent) controls(p game.Player) bool {
+	return o.enabled && o.player == p
+}
+
+// subcommands maps each subcommand name to its entry point
+// Running the binary without a subcommand plays a local game
+var subcommands = map[string]func(args []string) error{
+	"serve":       runServe,
+	"join":        runJoin,
+	"http":        runHTTP,
+	"stats":       runStats,
+	"leaderboard": runLeaderboard,
+	"replay":      runReplay,
+	"analyze":     runAnalyze,
+	"ultimate":    runUltimate,
+	"qubic":       runQubic,
+	"numerical":   runNumerical,
+}
+
 func main() {
+	if len(os.Args) > 1 {
+		if run, ok := subcommands[os.Args[1]]; ok {
+			if err := run(os.Args[2:]); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+	playLocal()
+}
+
+// playLocal plays a game on this terminal, optionally against the computer
+func playLocal() {
+	aiFlag := flag.String("ai", "", "let the computer play as X or O (single-player mode)")
+	difficultyFlag := flag.String("difficulty", "perfect", "computer strength: random, greedy, heuristic or perfect")
+	epsilonFlag := flag.Float64("epsilon", 0, "probability (0-1) that the computer plays a random move instead")
+	loadFlag := flag.String("load", "", "resume a game saved with the 'save' command")
+	positionFlag := flag.String("position", "", "start from a position string, e.g. \"X.O/.X./..O x\"")
+	tuiFlag := flag.Bool("tui", false, "play in a full-screen terminal interface")
+	bestOfFlag := flag.Int("best-of", 1, "play a match of up to N games, alternating who moves first")
+	xNameFlag := flag.String("x", "", "profile name of the player playing X, for recorded stats")
+	oNameFlag := flag.String("o", "", "profile name of the player playing O, for recorded stats")
+	statsFile := statsFileFlag(flag.CommandLine)
+	config := boardFlags(flag.CommandLine)
+	flag.Parse()
+
+	g, err := newGame(config(), *loadFlag, *positionFlag)
+	if errors.Is(err, errStartFlags) {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	computer, vsComputer, err := parseComputerPlayer(*aiFlag)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if g.Config.Variant == game.Wild && (vsComputer || *tuiFlag) {
+		fmt.Fprintln(os.Stderr, errWildFlags)
+		os.Exit(2)
+	}
+
+	strategy, err := newStrategy(*difficultyFlag, *epsilonFlag, g.Config, time.Now().UnixNano())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	computerSide := opponent{vsComputer, computer, strategy}
+	results := newRecorder(*statsFile, *xNameFlag, *oNameFlag, computerSide, *difficultyFlag, *epsilonFlag)
+
+	if *bestOfFlag != 1 && (*loadFlag != "" || *positionFlag != "" || *tuiFlag) {
+		fmt.Fprintln(os.Stderr, errMatchFlags)
+		os.Exit(2)
+	}
+
+	if *tuiFlag {
+		if err := playTUI(g, computerSide, results); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	fmt.Println("=== Tic-Tac-Toe ===")
+	if g.Config.Variant == game.Misere {
+		fmt.Println("Misère rules: whoever completes a line loses")
+	}
+	if g.Config.Variant == game.Wild {
+		fmt.Println("Wild rules: place X or O on each turn; whoever completes a line wins")
+	}
 	fmt.Println()
 
-	g := game.NewGame()
 	scanner := bufio.NewScanner(os.Stdin)
+	if *bestOfFlag != 1 {
+		match, err := game.NewMatch(g.Config, *bestOfFlag)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		displaySeriesResult(playMatch(match, scanner, computerSide, results))
+		return
+	}
 
+	playPrompt(g, scanner, computerSide, results)
+}
+
+// playPrompt plays g at the prompt, then shows the result and review
+// Only a game finished by a move played here is recorded, so reopening a
+// finished save does not count it again
+func playPrompt(g game.Game, scanner *bufio.Scanner, computer opponent, results recorder) {
+	g, played := runGame(g, scanner, computer, false)
+
+	// Display final board
+	displayBoard(g.Board)
+	fmt.Println()
+
+	displayResult(g.State)
+	if g.State != game.InProgress {
+		fmt.Println()
+		showReview(g)
+		if played {
+			results.record(g)
+		}
+	}
+}
+
+// playTUI plays g and any rematches in the full-screen terminal interface
+func playTUI(g game.Game, computer opponent, results recorder) error {
+	var c *tui.Computer
+	if computer.enabled {
+		c = &tui.Computer{Player: computer.player, Strategy: computer.strategy}
+	}
+
+	// A game that was already finished when opened was not played here, so
+	// only the games after it are recorded
+	skip := g.State != game.InProgress
+
+	// Printing would corrupt the full-screen display, so the first failure to
+	// record a result is reported once the interface closes
+	var recordErr error
+	err := tui.New(g, c).SetGameOverFunc(func(g game.Game) {
+		if skip {
+			skip = false
+			return
+		}
+		if err := results.save(g); err != nil && recordErr == nil {
+			recordErr = err
+		}
+	}).Run()
+	if err != nil {
+		return err
+	}
+	return recordErr
+}
+
+// runGame plays g until it finishes or input runs out and returns the last position
+// played reports whether a move made here finished the game, rather than the
+// game being finished when opened or reached by a command such as load
+// inMatch is passed on to handleCommand
+func runGame(g game.Game, scanner *bufio.Scanner, computer opponent, inMatch bool) (last game.Game, played bool) {
 	for g.State == game.InProgress {
 		// Display board
 		displayBoard(g.Board)
 
+		// Let the computer move when it is its turn
+		if computer.controls(g.CurrentPlayer) {
+			g = playComputerMove(g, computer.strategy)
+			played = g.State != game.InProgress
+			continue
+		}
+
 		// Display current player
 		fmt.Printf("\n%s's turn\n", g.CurrentPlayer.Name())
-		fmt.Print("Enter row and column (0-2), e.g., '1 1': ")
+		fmt.Println(commandList(g.Config))
+		if g.Config.Variant == game.Wild {
+			fmt.Print(markedMovePrompt(g.Board))
+		} else {
+			fmt.Print(movePrompt(g.Board))
+		}
 
 		// Read input
 		if !scanner.Scan() {
@@ -32,29 +251,90 @@ func main() {
 
 		input := scanner.Text()
 
-		// Validate and parse input using validation package
-		row, col, err := validation.ParseAndValidateInput(input)
-		if err != nil {
-			displayError(err)
+		// Handle prompt commands before treating input as a move
+		if newGame, ok := handleCommand(g, input, computer, inMatch); ok {
+			g = newGame
 			continue
 		}
 
-		// Make move
-		newGame, err := g.MakeMove(row, col)
+		// Validate input and make move
+		newGame, err := playInput(g, input)
 		if err != nil {
 			displayError(err)
 			continue
 		}
 
 		g = newGame
+		played = g.State != game.InProgress
 	}
+	return g, played
+}
 
-	// Display final board
-	displayBoard(g.Board)
+// commandList lists the prompt commands available in a game with config
+// Wild games have no hints, since the solver cannot search them
+func commandList(config game.Config) string {
+	if config.Variant == game.Wild {
+		return "Commands: undo, redo, save <file>, load <file>"
+	}
+	return "Commands: undo, redo, hint, save <file>, load <file>"
+}
+
+// playMatch plays the games of match until the series is decided or input runs out,
+// showing the scoreboard after each game, and returns the match so far
+func playMatch(match game.Match, scanner *bufio.Scanner, computer opponent, results recorder) game.Match {
+	for !match.IsOver() {
+		g, err := match.NextGame()
+		if err != nil {
+			displayError(err)
+			break
+		}
+		fmt.Printf("\n=== Game %d of up to %d: %s moves first ===\n",
+			match.GamesPlayed()+1, match.BestOf, g.CurrentPlayer.Name())
+
+		g, _ = runGame(g, scanner, computer, true)
+		displayBoard(g.Board)
+		fmt.Println()
+		if g.State == game.InProgress {
+			break // Input ran out mid-game
+		}
+		displayResult(g.State)
+		results.record(g)
+
+		if match, err = match.Record(g); err != nil {
+			displayError(err)
+			break
+		}
+		displayScoreboard(match)
+	}
+	return match
+}
+
+// displayScoreboard prints each player's wins, losses and draws so far
+func displayScoreboard(match game.Match) {
+	fmt.Printf("\nScoreboard after %d of up to %d games\n", match.GamesPlayed(), match.BestOf)
+	fmt.Printf("%-14s %4s %4s %4s\n", "", "W", "L", "D")
+	for _, p := range []game.Player{game.Player1, game.Player2} {
+		fmt.Printf("%-14s %4d %4d %4d\n", p.Name(), match.Wins(p), match.Losses(p), match.Draws())
+	}
+}
+
+// displaySeriesResult announces the winner of a match, or that it ended level or unfinished
+func displaySeriesResult(match game.Match) {
 	fmt.Println()
+	winner, decided := match.Winner()
+	switch {
+	case !match.IsOver():
+		fmt.Printf("Match abandoned after %d games\n", match.GamesPlayed())
+	case decided:
+		fmt.Printf("🏆 %s wins the match %d-%d!\n", winner.Name(), match.Wins(winner), match.Losses(winner))
+	default:
+		fmt.Printf("The match is tied %d-%d!\n", match.Wins(game.Player1), match.Wins(game.Player2))
+	}
+}
 
-	// Display result
-	switch g.State {
+// displayResult announces the outcome of a finished game
+func displayResult(state game.GameState) {
+	switch state {
 	case game.Player1Won:
 		fmt.Println("🎉 Player 1 (X) wins!")
 	case game.Player2Won:
@@ -64,21 +344,293 @@ func main() {
 	}
 }
 
+// handleCommand runs prompt commands such as "undo", "redo", "hint", "save <file>" and "load <file>"
+// Returns false if input is not a command so it can be parsed as a move
+// During a match (inMatch) load is refused, as -best-of refuses -load, so a
+// loaded game can neither score an unplayed result nor end the series
+func handleCommand(g game.Game, input string, computer opponent, inMatch bool) (game.Game, bool) {
+	fields := strings.Fields(input)
+	if len(fields) == 0 {
+		return g, false
+	}
+
+	switch strings.ToLower(fields[0]) {
+	case "undo":
+		return stepHistory(g, game.Game.Undo, computer), true
+	case "redo":
+		return stepHistory(g, game.Game.Redo, computer), true
+	case "hint":
+		showHint(g)
+		return g, true
+	case "save":
+		saveGame(g, fields[1:])
+		return g, true
+	case "load":
+		if inMatch {
+			displayError(errMatchLoad)
+			return g, true
+		}
+		return loadGame(g, fields[1:], computer), true
+	default:
+		return g, false
+	}
+}
+
+// saveGame writes g to the file named in args
+func saveGame(g game.Game, args []string) {
+	if len(args) != 1 {
+		displayError(errMissingFile)
+		return
+	}
+	if err := saveFile(args[0], g); err != nil {
+		displayError(err)
+		return
+	}
+	fmt.Printf("\nGame saved to %s\n", args[0])
+}
+
+// loadGame reads the game named in args, keeping g if loading fails
+// Against the computer the loaded game must use g's configuration and must
+// not be a wild game, as with the -ai flag at startup
+func loadGame(g game.Game, args []string, computer opponent) game.Game {
+	if len(args) != 1 {
+		displayError(errMissingFile)
+		return g
+	}
+	loaded, err := loadFile(args[0])
+	if err != nil {
+		displayError(err)
+		return g
+	}
+	if computer.enabled && loaded.Config.Variant == game.Wild {
+		displayError(errWildFlags)
+		return g
+	}
+	if computer.enabled && loaded.Config.String() != g.Config.String() {
+		displayError(errLoadConfig)
+		return g
+	}
+	fmt.Printf("\nGame loaded from %s\n", args[0])
+	return loaded
+}
+
+// notationExt is the file extension that selects game notation instead of JSON
+const notationExt = ".ttn"
+
+// saveFile writes g to path as game notation if path ends in .ttn, otherwise as JSON
+func saveFile(path string, g game.Game) error {
+	if filepath.Ext(path) != notationExt {
+		return game.SaveFile(path, g)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := notation.Write(f, g, []notation.Tag{{Name: notation.TagDate, Value: time.Now().Format("2006.01.02")}}); err != nil {
+		f.Close()
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return f.Close()
+}
+
+// loadFile reads the game at path, parsing game notation if path ends in .ttn
+func loadFile(path string) (game.Game, error) {
+	if filepath.Ext(path) != notationExt {
+		return game.LoadFile(path)
+	}
+	rec, err := readNotation(path)
+	if err != nil {
+		return game.Game{}, err
+	}
+	return rec.Final(), nil
+}
+
+// readNotation parses the game notation file at path
+func readNotation(path string) (notation.Record, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return notation.Record{}, err
+	}
+	defer f.Close()
+
+	rec, err := notation.Parse(f)
+	if err != nil {
+		return notation.Record{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return rec, nil
+}
+
+// stepHistory applies an undo or redo step, repeating it over the computer's
+// moves so that a human is to move afterwards whenever possible
+func stepHistory(g game.Game, step func(game.Game) (game.Game, error), computer opponent) game.Game {
+	next, err := step(g)
+	if err != nil {
+		displayError(err)
+		return g
+	}
+
+	for next.State == game.InProgress && computer.controls(next.CurrentPlayer) {
+		further, err := step(next)
+		if err != nil {
+			break
+		}
+		next = further
+	}
+	return next
+}
+
+// parseComputerPlayer converts the -ai flag into the player driven by the engine
+// An empty value disables single-player mode
+func parseComputerPlayer(value string) (game.Player, bool, error) {
+	switch strings.ToUpper(strings.TrimSpace(value)) {
+	case "":
+		return game.Player1, false, nil
+	case "X":
+		return game.Player1, true, nil
+	case "O":
+		return game.Player2, true, nil
+	default:
+		return game.Player1, false, errUnknownComputer
+	}
+}
+
+// boardFlags registers -width, -height, -win and -variant on fs and returns a
+// function that builds the board configuration once fs has been parsed
+func boardFlags(fs *flag.FlagSet) func() game.Config {
+	width := fs.Int("width", game.BOARD_SIZE, "number of columns on the board")
+	height := fs.Int("height", game.BOARD_SIZE, "number of rows on the board")
+	win := fs.Int("win", game.DefaultWinLength, "marks in a row needed to win")
+	var variant game.Variant
+	fs.Var(&variant, "variant", "rules: standard, misere where completing a line loses, or wild where either mark may be placed")
+	return func() game.Config {
+		return game.Config{Width: *width, Height: *height, WinLength: *win, Variant: variant}
+	}
+}
+
+// newGame starts a game with config, resumes the saved game at loadPath if
+// set, or starts from position if set
+// A loaded game keeps the board configuration it was saved with, and a
+// position's rows and win length replace config. A position that names no
+// variant is played under config's variant
+func newGame(config game.Config, loadPath, position string) (game.Game, error) {
+	switch {
+	case loadPath != "" && position != "":
+		return game.Game{}, errStartFlags
+	case loadPath != "":
+		return loadFile(loadPath)
+	case position != "":
+		g, err := game.ParsePosition(position)
+		if err != nil || g.Config.Variant != game.Standard || config.Variant == game.Standard {
+			return g, err
+		}
+		return game.ParsePosition(g.String() + " " + config.Variant.String())
+	}
+	return game.NewGameWithConfig(config)
+}
+
+// newStrategy builds the computer's strategy from the -difficulty and -epsilon flags
+func newStrategy(difficulty string, epsilon float64, config game.Config, seed int64) (ai.Strategy, error) {
+	d, err := ai.ParseDifficulty(difficulty)
+	if err != nil {
+		return nil, err
+	}
+	rng := rand.New(rand.NewPCG(uint64(seed), 0))
+	return ai.WithEpsilon(ai.New(d, config, rng), epsilon, rng)
+}
+
+// movePrompt asks for a move using the coordinate range of board
+func movePrompt(board game.Board) string {
+	example := fmt.Sprintf("'%d %d'", board.Height()/2, board.Width()/2)
+	if board.Width() == board.Height() {
+		return fmt.Sprintf("Enter row and column (0-%d), e.g., %s: ", board.Width()-1, example)
+	}
+	return fmt.Sprintf("Enter row (0-%d) and column (0-%d), e.g., %s: ", board.Height()-1, board.Width()-1, example)
+}
+
+// markedMovePrompt asks for a wild move, a mark followed by row and column
+func markedMovePrompt(board game.Board) string {
+	example := fmt.Sprintf("'X %d %d'", board.Height()/2, board.Width()/2)
+	return fmt.Sprintf("Enter X or O, row (0-%d) and column (0-%d), e.g., %s: ", board.Height()-1, board.Width()-1, example)
+}
+
+// playInput validates input with the validation package and plays it as a move
+// Wild games expect the mark to place before the row and column, e.g. "O 1 1"
+func playInput(g game.Game, input string) (game.Game, error) {
+	width, height := g.Board.Width(), g.Board.Height()
+	if g.Config.Variant != game.Wild {
+		row, col, err := validation.ParseAndValidateInputSize(input, width, height)
+		if err != nil {
+			return g, err
+		}
+		return g.MakeMove(row, col)
+	}
+
+	name, row, col, err := validation.ParseMarkedInputSize(input, width, height)
+	if err != nil {
+		return g, err
+	}
+	mark := game.X
+	if name == game.O.String() {
+		mark = game.O
+	}
+	return g.MakeMoveMark(row, col, mark)
+}
+
+// playComputerMove asks the strategy for a move and applies it
+func playComputerMove(g game.Game, strategy ai.Strategy) game.Game {
+	row, col, err := strategy.ChooseMove(g.Board, g.CurrentPlayer)
+	if err != nil {
+		displayError(err)
+		return g
+	}
+
+	newGame, err := g.MakeMove(row, col)
+	if err != nil {
+		displayError(err)
+		return g
+	}
+
+	fmt.Printf("\n%s (computer) plays %d %d\n", g.CurrentPlayer.Name(), row, col)
+	return newGame
+}
+
+// displayBoard prints the board with row and column numbers
 func displayBoard(board game.Board) {
+	displayBoardHighlight(board, nil)
+}
+
+// displayBoardHighlight prints the board with row and column numbers,
+// drawing highlightMark in the cell at highlight if it is set
+// Labels are padded so boards with two-digit coordinates stay aligned
+func displayBoardHighlight(board game.Board, highlight *game.Position) {
+	labelWidth := len(strconv.Itoa(board.Height() - 1))
+	indent := strings.Repeat(" ", labelWidth+1)
+
 	fmt.Println()
-	fmt.Println("  0   1   2")
-	for row := 0; row < 3; row++ {
-		fmt.Printf("%d ", row)
-		for col := 0; col < 3; col++ {
-			cell := board.GetCell(row, col)
-			fmt.Printf(" %s ", cell.String())
-			if col < 2 {
+	fmt.Print(indent)
+	for col := 0; col < board.Width(); col++ {
+		label := fmt.Sprintf("%-4d", col)
+		if col == board.Width()-1 {
+			label = strings.TrimRight(label, " ")
+		}
+		fmt.Print(label)
+	}
+	fmt.Println()
+	for row := 0; row < board.Height(); row++ {
+		fmt.Printf("%*d ", labelWidth, row)
+		for col := 0; col < board.Width(); col++ {
+			mark := board.GetCell(row, col).String()
+			if highlight != nil && *highlight == (game.Position{Row: row, Col: col}) {
+				mark = highlightMark
+			}
+			fmt.Printf(" %s ", mark)
+			if col < board.Width()-1 {
 				fmt.Print("|")
 			}
 		}
 		fmt.Println()
-		if row < 2 {
-			fmt.Println("  -----------")
+		if row < board.Height()-1 {
+			fmt.Println(indent + strings.Repeat("-", 4*board.Width()-1))
 		}
 	}
 	fmt.Println()
@@ -90,7 +642,19 @@ func displayError(err error) {
 	fmt.Println("╔════════════════════════════════════════════╗")
 
 	// Check for specific validation errors
+	var rangeErr *validation.RangeError
+	var layerErr *qubic.LayerError
 	switch {
+	case errors.As(err, &layerErr):
+		fmt.Println("║  ❌ Invalid Position                      ║")
+		fmt.Println("║                                            ║")
+		fmt.Printf("║  %-42s║\n", fmt.Sprintf("Layer must be between 0 and %d", qubic.Size-1))
+		fmt.Println("║  Example: '1 2 3' for layer 1, row 2, col 3║")
+	case errors.As(err, &rangeErr):
+		fmt.Println("║  ❌ Invalid Position                      ║")
+		fmt.Println("║                                            ║")
+		fmt.Printf("║  %-42s║\n", fmt.Sprintf("Row must be between 0 and %d", rangeErr.Height-1))
+		fmt.Printf("║  %-42s║\n", fmt.Sprintf("Column must be between 0 and %d", rangeErr.Width-1))
 	case errors.Is(err, validation.ErrInvalidRange):
 		fmt.Println("║  ❌ Invalid Position                      ║")
 		fmt.Println("║                                            ║")
@@ -106,11 +670,25 @@ func displayError(err error) {
 		fmt.Println("║                                            ║")
 		fmt.Println("║  Please enter two numbers separated by     ║")
 		fmt.Println("║  space (row and column)                    ║")
+	case errors.Is(err, validation.ErrInvalidMark):
+		fmt.Println("║  ❌ Invalid Mark                          ║")
+		fmt.Println("║                                            ║")
+		fmt.Println("║  Start the move with the mark to place     ║")
+		fmt.Println("║  Example: 'X 1 1' or 'O 0 2'               ║")
 	case errors.Is(err, game.ErrCellOccupied):
 		fmt.Println("║  ❌ Cell Already Occupied                 ║")
 		fmt.Println("║                                            ║")
 		fmt.Println("║  That position is already taken            ║")
 		fmt.Println("║  Please choose an empty cell               ║")
+	case errors.Is(err, solver.ErrTooLarge):
+		fmt.Println("║  ❌ Hint Unavailable                      ║")
+		fmt.Println("║                                            ║")
+		fmt.Printf("║  %-42s║\n", fmt.Sprintf("Hints need at most %d empty cells", solver.MaxEmptyCells))
+		fmt.Println("║  Play on and ask again later               ║")
+	case errors.Is(err, solver.ErrUnsupportedVariant):
+		fmt.Println("║  ❌ Hint Unavailable                      ║")
+		fmt.Println("║                                            ║")
+		fmt.Println("║  Hints are not available in wild games     ║")
 	default:
 		// Generic error display
 		fmt.Println("║  ❌ Error                                 ║")
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+	"github.com/YOUR_USERNAME/tictactoe/network"
+	"github.com/YOUR_USERNAME/tictactoe/validation"
+)
+
+// defaultServeAddr is the address `tictactoe serve` listens on by default
+const defaultServeAddr = ":7777"
+
+// errJoinAddress indicates join was run without exactly one server address
+var errJoinAddress = errors.New("usage: tictactoe join host:port")
+
+// runServe implements `tictactoe serve`: host networked games until interrupted
+func runServe(args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	addr := fs.String("addr", defaultServeAddr, "address to listen on")
+	config := boardFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	server, err := network.NewServer(config())
+	if err != nil {
+		return err
+	}
+	ln, err := net.Listen("tcp", *addr)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Serving %s tic-tac-toe on %s\n", server.Config, ln.Addr())
+	return server.Serve(ln)
+}
+
+// runJoin implements `tictactoe join host:port`: play a networked game from this terminal
+func runJoin(args []string) error {
+	fs := flag.NewFlagSet("join", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errJoinAddress
+	}
+
+	client, err := network.Dial(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	fmt.Println("=== Tic-Tac-Toe (network) ===")
+	return playRemote(client, bufio.NewScanner(os.Stdin))
+}
+
+// playRemote follows the server's messages, prompting for a move whenever it is
+// this client's turn, until the game ends or input runs out
+// Returns network.ErrBadMessage if the server repeats hello, sends a state
+// before hello or sends a state without a game
+func playRemote(client *network.Client, scanner *bufio.Scanner) error {
+	var me game.Player
+	var current *game.Game // Latest position, nil until the first state arrives
+	seated := false
+
+	for {
+		msg, err := client.Receive()
+		if err != nil {
+			return err
+		}
+
+		switch msg.Type {
+		case network.TypeHello:
+			if seated {
+				return fmt.Errorf("%w: hello received twice", network.ErrBadMessage)
+			}
+			if me, err = network.ParsePlayer(msg.Player); err != nil {
+				return err
+			}
+			seated = true
+			fmt.Printf("\nYou are %s. Waiting for an opponent...\n", me.Name())
+			continue
+		case network.TypeState:
+			switch {
+			case !seated:
+				return fmt.Errorf("%w: state received before hello", network.ErrBadMessage)
+			case msg.Game == nil:
+				return fmt.Errorf("%w: state without a game", network.ErrBadMessage)
+			}
+			current = msg.Game
+			displayBoard(current.Board)
+		case network.TypeError:
+			displayError(errors.New(msg.Message))
+		case network.TypeGameOver:
+			fmt.Printf("\n%s\n", msg.Reason)
+			return nil
+		}
+
+		if current == nil || current.State != game.InProgress {
+			continue
+		}
+		if current.CurrentPlayer != me {
+			fmt.Printf("\nWaiting for %s...\n", current.CurrentPlayer.Name())
+			continue
+		}
+		if !sendRemoteMove(client, current.Board, scanner) {
+			return nil
+		}
+	}
+}
+
+// sendRemoteMove reads moves until one passes local validation and sends it
+// Returns false if input runs out
+func sendRemoteMove(client *network.Client, board game.Board, scanner *bufio.Scanner) bool {
+	for {
+		fmt.Print("\nYour turn. " + movePrompt(board))
+		if !scanner.Scan() {
+			return false
+		}
+
+		row, col, err := validation.ParseAndValidateInputSize(scanner.Text(), board.Width(), board.Height())
+		if err != nil {
+			displayError(err)
+			continue
+		}
+		if err := client.SendMove(row, col); err != nil {
+			displayError(err)
+			return false
+		}
+		return true
+	}
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+	"github.com/YOUR_USERNAME/tictactoe/network"
+)
+
+// TestPlayRemoteFullGame plays a networked game between two scripted clients
+func TestPlayRemoteFullGame(t *testing.T) {
+	server, err := network.NewServer(game.StandardConfig())
+	if err != nil {
+		t.Fatalf("NewServer() returned error: %v", err)
+	}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() returned error: %v", err)
+	}
+	defer ln.Close()
+	go server.Serve(ln)
+
+	// X wins along the top row; O's first input is invalid and re-prompted
+	scripts := []string{"0 0\n0 1\n0 2\n", "a b\n1 0\n1 1\n"}
+	results := make(chan error, len(scripts))
+	for _, script := range scripts {
+		client, err := network.Dial(ln.Addr().String())
+		if err != nil {
+			t.Fatalf("Dial() returned error: %v", err)
+		}
+		defer client.Close()
+		go func(input string) {
+			results <- playRemote(client, bufio.NewScanner(strings.NewReader(input)))
+		}(script)
+	}
+
+	for range scripts {
+		if err := <-results; err != nil {
+			t.Errorf("playRemote() returned error: %v", err)
+		}
+	}
+}
+
+// TestPlayRemoteRejectsBadMessages verifies out-of-order and incomplete server
+// messages end the game with ErrBadMessage instead of crashing
+func TestPlayRemoteRejectsBadMessages(t *testing.T) {
+	const hello = `{"type":"hello","player":"X"}`
+	tests := []struct {
+		name  string
+		lines []string
+	}{
+		{"State without a game", []string{hello, `{"type":"state"}`}},
+		{"State before hello", []string{`{"type":"state","game":{"version":3,"board":["...","...","..."],` +
+			`"current_player":"X","state":"in_progress","move_count":0,"history":[]}}`}},
+		{"Hello twice", []string{hello, `{"type":"hello","player":"O"}`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serverConn, clientConn := net.Pipe()
+			defer serverConn.Close()
+			go func() {
+				for _, line := range tt.lines {
+					if _, err := serverConn.Write([]byte(line + "\n")); err != nil {
+						return
+					}
+				}
+			}()
+
+			client := network.NewClient(clientConn)
+			defer client.Close()
+			err := playRemote(client, bufio.NewScanner(strings.NewReader("")))
+			if !errors.Is(err, network.ErrBadMessage) {
+				t.Errorf("playRemote() error = %v, want ErrBadMessage", err)
+			}
+		})
+	}
+}
+
+// TestRunJoinRequiresAddress verifies join needs exactly one address
+func TestRunJoinRequiresAddress(t *testing.T) {
+	if err := runJoin(nil); !errors.Is(err, errJoinAddress) {
+		t.Errorf("runJoin() error = %v, want errJoinAddress", err)
+	}
+}
@@ -0,0 +1,60 @@
+package network
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+)
+
+// Client is one player's connection to a Server
+type Client struct {
+	conn    net.Conn
+	scanner *bufio.Scanner
+	encoder *json.Encoder
+}
+
+// Dial connects to the server at addr (host:port)
+func Dial(addr string) (*Client, error) {
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(conn), nil
+}
+
+// NewClient wraps an established connection
+func NewClient(conn net.Conn) *Client {
+	return &Client{
+		conn:    conn,
+		scanner: bufio.NewScanner(conn),
+		encoder: json.NewEncoder(conn),
+	}
+}
+
+// Receive blocks until the next message arrives from the server
+// Returns ErrConnectionClosed once the server hangs up
+func (c *Client) Receive() (Message, error) {
+	if !c.scanner.Scan() {
+		if err := c.scanner.Err(); err != nil {
+			return Message{}, err
+		}
+		return Message{}, ErrConnectionClosed
+	}
+
+	var msg Message
+	if err := json.Unmarshal(c.scanner.Bytes(), &msg); err != nil {
+		return Message{}, fmt.Errorf("%w: %v", ErrBadMessage, err)
+	}
+	return msg, nil
+}
+
+// SendMove asks the server to place the client's mark at (row, col)
+func (c *Client) SendMove(row, col int) error {
+	return c.encoder.Encode(NewMove(row, col))
+}
+
+// Close disconnects from the server
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,142 @@
+// Package network implements networked two-player tic-tac-toe over TCP
+//
+// The server owns the authoritative game.Game and speaks a newline-delimited
+// JSON protocol: every message is one JSON object on its own line with a
+// "type" field.
+//
+// Server to client:
+//
+//	{"type":"hello","player":"X"}                     seat assigned on connect
+//	{"type":"state","game":{...}}                     position after both players join and after every move
+//	{"type":"error","message":"Not your turn"}        a move was rejected; the game continues
+//	{"type":"game-over","result":"player1_won","reason":"..."}
+//
+// The "game" object uses the save file schema written by game.Save.
+// "result" is one of player1_won, player2_won, draw or abandoned; abandoned
+// means the opponent disconnected before the game finished.
+//
+// Client to server:
+//
+//	{"type":"move","row":1,"col":1}
+//
+// Moves from the player who is not to move are rejected with an error message.
+package network
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// Message types
+const (
+	TypeHello    = "hello"
+	TypeMove     = "move"
+	TypeState    = "state"
+	TypeError    = "error"
+	TypeGameOver = "game-over"
+)
+
+// Game-over results
+const (
+	ResultPlayer1Won = "player1_won"
+	ResultPlayer2Won = "player2_won"
+	ResultDraw       = "draw"
+	ResultAbandoned  = "abandoned"
+)
+
+// Protocol errors
+var (
+	// ErrNotYourTurn is sent when a player moves while the opponent is to move
+	ErrNotYourTurn = errors.New("Not your turn. Wait for your opponent to move")
+
+	// ErrBadMessage is sent when a client message cannot be understood
+	ErrBadMessage = errors.New("Invalid message. Send {\"type\":\"move\",\"row\":R,\"col\":C}")
+
+	// ErrConnectionClosed is returned by Client.Receive once the server hangs up
+	ErrConnectionClosed = errors.New("connection closed by server")
+
+	// ErrUnsupportedVariant is returned by NewServer for wild games, whose
+	// moves carry a mark that move messages cannot express
+	ErrUnsupportedVariant = errors.New("network play does not support the wild variant")
+)
+
+// Message is a single line of the protocol
+// Only the fields relevant to Type are set
+type Message struct {
+	Type    string     `json:"type"`
+	Player  string     `json:"player,omitempty"`  // hello: the mark assigned to this client
+	Row     *int       `json:"row,omitempty"`     // move: row of the cell
+	Col     *int       `json:"col,omitempty"`     // move: column of the cell
+	Game    *game.Game `json:"game,omitempty"`    // state: the current position
+	Message string     `json:"message,omitempty"` // error: why the move was rejected
+	Result  string     `json:"result,omitempty"`  // game-over: how the game ended
+	Reason  string     `json:"reason,omitempty"`  // game-over: human-readable explanation
+}
+
+// NewMove returns a move message for the given cell
+func NewMove(row, col int) Message {
+	return Message{Type: TypeMove, Row: &row, Col: &col}
+}
+
+// newHello returns the seat assignment message for player
+func newHello(player game.Player) Message {
+	return Message{Type: TypeHello, Player: player.GetMark().String()}
+}
+
+// newState returns a state message for g
+func newState(g game.Game) Message {
+	return Message{Type: TypeState, Game: &g}
+}
+
+// newError returns an error message describing err
+func newError(err error) Message {
+	return Message{Type: TypeError, Message: err.Error()}
+}
+
+// newGameOver returns the game-over message for a finished game
+func newGameOver(state game.GameState) Message {
+	switch state {
+	case game.Player1Won:
+		return Message{Type: TypeGameOver, Result: ResultPlayer1Won, Reason: "Player 1 (X) wins"}
+	case game.Player2Won:
+		return Message{Type: TypeGameOver, Result: ResultPlayer2Won, Reason: "Player 2 (O) wins"}
+	default:
+		return Message{Type: TypeGameOver, Result: ResultDraw, Reason: "It's a draw"}
+	}
+}
+
+// newAbandoned returns the game-over message sent when a player disconnects
+func newAbandoned(leaver game.Player) Message {
+	return Message{
+		Type:   TypeGameOver,
+		Result: ResultAbandoned,
+		Reason: fmt.Sprintf("%s disconnected", leaver.Name()),
+	}
+}
+
+// ParsePlayer converts a hello message's player mark into a game.Player
+func ParsePlayer(mark string) (game.Player, error) {
+	switch mark {
+	case "X":
+		return game.Player1, nil
+	case "O":
+		return game.Player2, nil
+	default:
+		return game.Player1, fmt.Errorf("%w: unknown player %q", ErrBadMessage, mark)
+	}
+}
+
+// decodeMove parses a client line into a move
+func decodeMove(line []byte) (int, int, error) {
+	var msg Message
+	if err := json.Unmarshal(line, &msg); err != nil {
+		return 0, 0, ErrBadMessage
+	}
+	if msg.Type != TypeMove || msg.Row == nil || msg.Col == nil {
+		return 0, 0, ErrBadMessage
+	}
+	return *msg.Row, *msg.Col, nil
+}
@@ -0,0 +1,81 @@
+package network
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// TestMoveWireFormat verifies move messages match the documented protocol
+func TestMoveWireFormat(t *testing.T) {
+	data, err := json.Marshal(NewMove(0, 2))
+	if err != nil {
+		t.Fatalf("json.Marshal() returned error: %v", err)
+	}
+	if want := `{"type":"move","row":0,"col":2}`; string(data) != want {
+		t.Errorf("json.Marshal(NewMove(0, 2)) = %s, want %s", data, want)
+	}
+}
+
+// TestDecodeMove verifies client lines are parsed into moves
+func TestDecodeMove(t *testing.T) {
+	tests := []struct {
+		name      string
+		line      string
+		wantRow   int
+		wantCol   int
+		wantError bool
+	}{
+		{"Valid move", `{"type":"move","row":2,"col":1}`, 2, 1, false},
+		{"Zero coordinates", `{"type":"move","row":0,"col":0}`, 0, 0, false},
+		{"Missing column", `{"type":"move","row":1}`, 0, 0, true},
+		{"Wrong type", `{"type":"hello","row":1,"col":1}`, 0, 0, true},
+		{"Not JSON", `1 1`, 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row, col, err := decodeMove([]byte(tt.line))
+			if tt.wantError {
+				if !errors.Is(err, ErrBadMessage) {
+					t.Errorf("decodeMove(%s) error = %v, want ErrBadMessage", tt.line, err)
+				}
+				return
+			}
+			if err != nil || row != tt.wantRow || col != tt.wantCol {
+				t.Errorf("decodeMove(%s) = (%d, %d, %v), want (%d, %d, nil)",
+					tt.line, row, col, err, tt.wantRow, tt.wantCol)
+			}
+		})
+	}
+}
+
+// TestNewGameOver verifies finished states map to protocol results
+func TestNewGameOver(t *testing.T) {
+	tests := []struct {
+		state game.GameState
+		want  string
+	}{
+		{game.Player1Won, ResultPlayer1Won},
+		{game.Player2Won, ResultPlayer2Won},
+		{game.Draw, ResultDraw},
+	}
+
+	for _, tt := range tests {
+		if got := newGameOver(tt.state); got.Type != TypeGameOver || got.Result != tt.want {
+			t.Errorf("newGameOver(%v) = %+v, want result %q", tt.state, got, tt.want)
+		}
+	}
+}
+
+// TestParsePlayer verifies hello marks map to players
+func TestParsePlayer(t *testing.T) {
+	if p, err := ParsePlayer("O"); err != nil || p != game.Player2 {
+		t.Errorf("ParsePlayer(\"O\") = (%v, %v), want Player2", p, err)
+	}
+	if _, err := ParsePlayer("Z"); !errors.Is(err, ErrBadMessage) {
+		t.Errorf("ParsePlayer(\"Z\") error = %v, want ErrBadMessage", err)
+	}
+}
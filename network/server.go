@@ -0,0 +1,222 @@
+package network
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"net"
+	"os"
+	"time"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// Server pairs incoming connections and referees one game per pair
+type Server struct {
+	Config game.Config // Board configuration for every game
+}
+
+// NewServer returns a server that hosts games with config
+// Returns ErrUnsupportedVariant for the wild variant
+func NewServer(config game.Config) (*Server, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	if config.Variant == game.Wild {
+		return nil, ErrUnsupportedVariant
+	}
+	return &Server{Config: config}, nil
+}
+
+// Serve accepts connections on ln, seating the first of each pair as X and
+// the second as O, and runs each game in its own goroutine
+// A player who disconnects while waiting for an opponent is dropped, and the
+// next connection takes their seat as X
+// It returns nil once ln is closed
+func (s *Server) Serve(ln net.Listener) error {
+	accepted := make(chan net.Conn)
+	acceptErr := make(chan error, 1)
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				acceptErr <- acceptError(err)
+				return
+			}
+			accepted <- conn
+		}
+	}()
+
+	var waiting *lobby
+	for {
+		var left <-chan error // nil, and so never ready, while nobody is waiting
+		if waiting != nil {
+			left = waiting.done
+		}
+
+		select {
+		case conn := <-accepted:
+			if waiting != nil && !waiting.release() {
+				waiting.conn.Close()
+				waiting = nil
+			}
+			if waiting == nil {
+				send(conn, newHello(game.Player1))
+				waiting = watch(conn)
+				continue
+			}
+			send(conn, newHello(game.Player2))
+			go s.runSession(waiting.conn, conn)
+			waiting = nil
+		case <-left:
+			waiting.conn.Close()
+			waiting = nil
+		case err := <-acceptErr:
+			if waiting != nil {
+				waiting.conn.Close()
+			}
+			return err
+		}
+	}
+}
+
+// lobby watches a player waiting for an opponent so that Serve notices if
+// they disconnect; anything they send before the game starts is discarded
+type lobby struct {
+	conn net.Conn
+	done chan error // Receives the read error that ended the watch
+}
+
+// watch starts watching conn until it fails or is released
+func watch(conn net.Conn) *lobby {
+	l := &lobby{conn: conn, done: make(chan error, 1)}
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			if _, err := conn.Read(buf); err != nil {
+				l.done <- err
+				return
+			}
+		}
+	}()
+	return l
+}
+
+// release stops watching so the connection can be handed to a session
+// Returns false if the player disconnected before the watch ended
+func (l *lobby) release() bool {
+	l.conn.SetReadDeadline(time.Now())
+	err := <-l.done
+	l.conn.SetReadDeadline(time.Time{})
+	return errors.Is(err, os.ErrDeadlineExceeded)
+}
+
+// acceptError hides the error returned when the listener is closed on purpose
+func acceptError(err error) error {
+	if errors.Is(err, net.ErrClosed) {
+		return nil
+	}
+	return err
+}
+
+// event is a line read from one of the players, or the error that ended their connection
+type event struct {
+	player game.Player
+	line   []byte
+	err    error
+}
+
+// session is a single game between two connected players
+// Only the session's goroutine touches the game or writes to the connections
+type session struct {
+	conns map[game.Player]net.Conn
+	game  game.Game
+}
+
+// runSession referees a single game between x and o
+func (s *Server) runSession(x, o net.Conn) {
+	defer x.Close()
+	defer o.Close()
+
+	g, _ := game.NewGameWithConfig(s.Config) // Config was validated by NewServer
+	sess := &session{conns: map[game.Player]net.Conn{game.Player1: x, game.Player2: o}, game: g}
+
+	events := make(chan event)
+	done := make(chan struct{})
+	defer close(done)
+	go readLines(x, game.Player1, events, done)
+	go readLines(o, game.Player2, events, done)
+
+	sess.broadcast(newState(sess.game))
+	for sess.game.State == game.InProgress {
+		ev := <-events
+		if ev.err != nil {
+			send(sess.conns[ev.player.Other()], newAbandoned(ev.player))
+			return
+		}
+		sess.applyMove(ev)
+	}
+	sess.broadcast(newGameOver(sess.game.State))
+}
+
+// applyMove validates a player's move and broadcasts the new state,
+// or reports the problem to the sender and leaves the game unchanged
+func (sess *session) applyMove(ev event) {
+	sender := sess.conns[ev.player]
+	row, col, err := decodeMove(ev.line)
+	if err != nil {
+		send(sender, newError(err))
+		return
+	}
+	if ev.player != sess.game.CurrentPlayer {
+		send(sender, newError(ErrNotYourTurn))
+		return
+	}
+
+	next, err := sess.game.MakeMove(row, col)
+	if err != nil {
+		send(sender, newError(err))
+		return
+	}
+	sess.game = next
+	sess.broadcast(newState(sess.game))
+}
+
+// broadcast sends msg to both players
+func (sess *session) broadcast(msg Message) {
+	for _, conn := range sess.conns {
+		send(conn, msg)
+	}
+}
+
+// readLines forwards each line from conn as an event until the connection fails
+// or the session ends
+func readLines(conn net.Conn, player game.Player, events chan<- event, done <-chan struct{}) {
+	scanner := bufio.NewScanner(conn)
+	for scanner.Scan() {
+		line := append([]byte(nil), scanner.Bytes()...)
+		select {
+		case events <- event{player: player, line: line}:
+		case <-done:
+			return
+		}
+	}
+
+	err := scanner.Err()
+	if err == nil {
+		err = ErrConnectionClosed
+	}
+	select {
+	case events <- event{player: player, err: err}:
+	case <-done:
+	}
+}
+
+// send writes msg as a single JSON line; write errors surface as read errors later
+func send(conn net.Conn, msg Message) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return
+	}
+	conn.Write(append(data, '\n'))
+}
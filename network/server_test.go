@@ -0,0 +1,219 @@
+package network
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// startServer runs a standard server on a random localhost port
+func startServer(t *testing.T) string {
+	t.Helper()
+	server, err := NewServer(game.StandardConfig())
+	if err != nil {
+		t.Fatalf("NewServer() returned error: %v", err)
+	}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() returned error: %v", err)
+	}
+	go server.Serve(ln)
+	t.Cleanup(func() { ln.Close() })
+	return ln.Addr().String()
+}
+
+// joinPair connects two clients and consumes their hello and initial state
+func joinPair(t *testing.T, addr string) (*Client, *Client) {
+	t.Helper()
+	x := dial(t, addr)
+	expect(t, x, TypeHello)
+	o := dial(t, addr)
+	expect(t, o, TypeHello)
+	expect(t, x, TypeState)
+	expect(t, o, TypeState)
+	return x, o
+}
+
+// dial connects a client and closes it when the test ends
+func dial(t *testing.T, addr string) *Client {
+	t.Helper()
+	c, err := Dial(addr)
+	if err != nil {
+		t.Fatalf("Dial() returned error: %v", err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+// expect receives the next message and checks its type
+func expect(t *testing.T, c *Client, msgType string) Message {
+	t.Helper()
+	msg, err := c.Receive()
+	if err != nil {
+		t.Fatalf("Receive() returned error: %v", err)
+	}
+	if msg.Type != msgType {
+		t.Fatalf("Receive() type = %q (%+v), want %q", msg.Type, msg, msgType)
+	}
+	return msg
+}
+
+// move sends a move and checks that both players receive the new state
+func move(t *testing.T, mover, other *Client, row, col int) game.Game {
+	t.Helper()
+	if err := mover.SendMove(row, col); err != nil {
+		t.Fatalf("SendMove(%d, %d) returned error: %v", row, col, err)
+	}
+	state := expect(t, mover, TypeState)
+	expect(t, other, TypeState)
+	return *state.Game
+}
+
+// TestHelloAssignsSeats verifies the first client plays X and the second O
+func TestHelloAssignsSeats(t *testing.T) {
+	addr := startServer(t)
+
+	x := dial(t, addr)
+	if msg := expect(t, x, TypeHello); msg.Player != "X" {
+		t.Errorf("First hello player = %q, want X", msg.Player)
+	}
+	o := dial(t, addr)
+	if msg := expect(t, o, TypeHello); msg.Player != "O" {
+		t.Errorf("Second hello player = %q, want O", msg.Player)
+	}
+
+	state := expect(t, x, TypeState)
+	if state.Game == nil || state.Game.CurrentPlayer != game.Player1 || state.Game.MoveCount != 0 {
+		t.Errorf("Initial state = %+v, want a new game with X to move", state.Game)
+	}
+}
+
+// TestFullGameOverNetwork verifies a game is played to a win
+func TestFullGameOverNetwork(t *testing.T) {
+	x, o := joinPair(t, startServer(t))
+
+	move(t, x, o, 0, 0)
+	move(t, o, x, 1, 0)
+	move(t, x, o, 0, 1)
+	move(t, o, x, 1, 1)
+	final := move(t, x, o, 0, 2)
+
+	if final.State != game.Player1Won {
+		t.Errorf("Final state = %v, want Player1Won", final.State)
+	}
+	for _, c := range []*Client{x, o} {
+		if msg := expect(t, c, TypeGameOver); msg.Result != ResultPlayer1Won {
+			t.Errorf("game-over result = %q, want %q", msg.Result, ResultPlayer1Won)
+		}
+	}
+}
+
+// TestRejectsOutOfTurnAndIllegalMoves verifies errors go only to the offending player
+func TestRejectsOutOfTurnAndIllegalMoves(t *testing.T) {
+	x, o := joinPair(t, startServer(t))
+
+	// O moves first
+	if err := o.SendMove(1, 1); err != nil {
+		t.Fatalf("SendMove() returned error: %v", err)
+	}
+	if msg := expect(t, o, TypeError); msg.Message != ErrNotYourTurn.Error() {
+		t.Errorf("Error message = %q, want %q", msg.Message, ErrNotYourTurn.Error())
+	}
+
+	// X plays, then O tries the occupied cell and an off-board cell
+	move(t, x, o, 1, 1)
+	if err := o.SendMove(1, 1); err != nil {
+		t.Fatalf("SendMove() returned error: %v", err)
+	}
+	if msg := expect(t, o, TypeError); msg.Message != game.ErrCellOccupied.Error() {
+		t.Errorf("Error message = %q, want %q", msg.Message, game.ErrCellOccupied.Error())
+	}
+	if err := o.SendMove(5, 5); err != nil {
+		t.Fatalf("SendMove() returned error: %v", err)
+	}
+	expect(t, o, TypeError)
+
+	// The game continues normally and X never saw the errors
+	g := move(t, o, x, 0, 0)
+	if g.MoveCount != 2 {
+		t.Errorf("MoveCount = %d, want 2", g.MoveCount)
+	}
+}
+
+// TestRejectsMalformedMessages verifies unparseable lines get an error reply
+func TestRejectsMalformedMessages(t *testing.T) {
+	x, _ := joinPair(t, startServer(t))
+
+	if _, err := x.conn.Write([]byte("1 1\n")); err != nil {
+		t.Fatalf("Write() returned error: %v", err)
+	}
+	if msg := expect(t, x, TypeError); msg.Message != ErrBadMessage.Error() {
+		t.Errorf("Error message = %q, want %q", msg.Message, ErrBadMessage.Error())
+	}
+}
+
+// TestDisconnectAbandonsGame verifies the remaining player is told when the opponent leaves
+func TestDisconnectAbandonsGame(t *testing.T) {
+	x, o := joinPair(t, startServer(t))
+	move(t, x, o, 1, 1)
+
+	x.Close()
+
+	msg := expect(t, o, TypeGameOver)
+	if msg.Result != ResultAbandoned {
+		t.Errorf("game-over result = %q, want %q", msg.Result, ResultAbandoned)
+	}
+	if _, err := o.Receive(); !errors.Is(err, ErrConnectionClosed) {
+		t.Errorf("Receive() after game over error = %v, want ErrConnectionClosed", err)
+	}
+}
+
+// TestServerDropsWaitingPlayerWhoLeaves verifies a player who disconnects before
+// an opponent arrives is not paired, and the next client takes the X seat
+func TestServerDropsWaitingPlayerWhoLeaves(t *testing.T) {
+	addr := startServer(t)
+	gone := dial(t, addr)
+	expect(t, gone, TypeHello)
+	gone.Close()
+	// Give the server a moment to see the connection close before the next client arrives
+	time.Sleep(50 * time.Millisecond)
+
+	x := dial(t, addr)
+	if hello := expect(t, x, TypeHello); hello.Player != "X" {
+		t.Fatalf("hello player = %q, want X after the waiting player left", hello.Player)
+	}
+	o := dial(t, addr)
+	expect(t, o, TypeHello)
+	expect(t, x, TypeState)
+	expect(t, o, TypeState)
+	move(t, x, o, 1, 1)
+}
+
+// TestServerHostsConsecutiveGames verifies later pairs get their own game
+func TestServerHostsConsecutiveGames(t *testing.T) {
+	addr := startServer(t)
+	x1, o1 := joinPair(t, addr)
+	x2, o2 := joinPair(t, addr)
+
+	move(t, x1, o1, 0, 0)
+	g := move(t, x2, o2, 2, 2)
+	if g.Board.GetCell(0, 0) != game.Empty {
+		t.Error("Second game shares state with the first")
+	}
+}
+
+// TestNewServerRejectsWild verifies wild games cannot be hosted
+func TestNewServerRejectsWild(t *testing.T) {
+	config := game.StandardConfig()
+	config.Variant = game.Wild
+	if _, err := NewServer(config); !errors.Is(err, ErrUnsupportedVariant) {
+		t.Errorf("NewServer(wild) error = %v, want ErrUnsupportedVariant", err)
+	}
+	config.Variant = game.Misere
+	if _, err := NewServer(config); err != nil {
+		t.Errorf("NewServer(misere) returned error: %v", err)
+	}
+}
@@ -0,0 +1,150 @@
+// Package notation reads and writes whole games in a PGN-like text format
+//
+// A record is a header of tag pairs followed by the moves in order:
+//
+//	[Event "Club night"]
+//	[Date "2026.10.17"]
+//	[X "alice"]
+//	[O "bob"]
+//	[Board "3x3/3"]
+//	[Result "1-0"]
+//
+//	1. b2 a1 2. c3 a3 3. a2 b1 4. c2 1-0
+//
+// Squares are a column letter followed by a row number, matching the grid as
+// displayed: a1 is the top-left cell (row 0, column 0), c1 the top-right
+// cell and a3 the bottom-left cell of the classic board. In wild games each
+// square is prefixed by the mark placed there, as in "Ob2"; elsewhere the
+// prefix may be given but must be the mover's own mark.
+//
+// The Board tag holds the configuration in WIDTHxHEIGHT/K form and defaults
+// to 3x3/3. The First tag is "O" when O made the first move and is omitted
+// otherwise. The result is 1-0 when X won, 0-1 when O won, 1/2-1/2 for a draw
+// and * for an unfinished game; it ends the move list and, if present, must
+// agree with the Result tag. Move numbers such as "1." are optional and text
+// in braces is a comment.
+package notation
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// Standard tag names
+const (
+	TagEvent  = "Event"
+	TagSite   = "Site"
+	TagDate   = "Date"
+	TagX      = "X"
+	TagO      = "O"
+	TagBoard  = "Board"
+	TagFirst  = "First"
+	TagResult = "Result"
+)
+
+// Game results as written in the Result tag and at the end of the moves
+const (
+	ResultXWins      = "1-0"
+	ResultOWins      = "0-1"
+	ResultDraw       = "1/2-1/2"
+	ResultUnfinished = "*"
+)
+
+// Notation errors
+var (
+	// ErrSyntax indicates text that is not valid notation
+	ErrSyntax = errors.New("syntax error")
+
+	// ErrIllegalMove indicates a well-formed move the game does not allow
+	ErrIllegalMove = errors.New("illegal move")
+
+	// ErrResultMismatch indicates a result that disagrees with the moves or the Result tag
+	ErrResultMismatch = errors.New("result does not match the game")
+
+	// ErrIncompleteHistory indicates a game whose history does not reach back to an empty board
+	ErrIncompleteHistory = errors.New("game history does not include every move")
+)
+
+// Tag is a single header tag pair
+type Tag struct {
+	Name  string
+	Value string
+}
+
+// Record is a parsed game
+type Record struct {
+	Tags      []Tag       // Header tags in the order they appeared
+	Positions []game.Game // Positions[0] is the start, Positions[i] the position after move i
+	Result    string      // One of the Result constants
+}
+
+// Tag returns the value of the named tag, or false if it is not present
+func (r Record) Tag(name string) (string, bool) {
+	return lookup(r.Tags, name)
+}
+
+// Final returns the position after the last move
+func (r Record) Final() game.Game {
+	return r.Positions[len(r.Positions)-1]
+}
+
+// ParseError reports malformed notation at a 1-based line and column
+type ParseError struct {
+	Line   int
+	Column int
+	Err    error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %v", e.Line, e.Column, e.Err)
+}
+
+// Unwrap returns the underlying error, e.g. ErrSyntax or game.ErrCellOccupied
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// FormatSquare returns the algebraic name of a cell, e.g. "b2" for row 1, column 1
+func FormatSquare(row, col int) string {
+	return string(rune('a'+col)) + strconv.Itoa(row+1)
+}
+
+// ParseSquare converts an algebraic square such as "b2" into a row and column
+// The result is not checked against any board size
+func ParseSquare(s string) (row, col int, err error) {
+	if len(s) < 2 || s[0] < 'a' || s[0] > 'z' || s[1] < '1' || s[1] > '9' {
+		return 0, 0, fmt.Errorf("%w: %q is not a square like b2", ErrSyntax, s)
+	}
+	n, err := strconv.Atoi(s[1:])
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: %q is not a square like b2", ErrSyntax, s)
+	}
+	return n - 1, int(s[0] - 'a'), nil
+}
+
+// ResultOf returns the result notation for a game state
+func ResultOf(state game.GameState) string {
+	switch state {
+	case game.Player1Won:
+		return ResultXWins
+	case game.Player2Won:
+		return ResultOWins
+	case game.Draw:
+		return ResultDraw
+	default:
+		return ResultUnfinished
+	}
+}
+
+// lookup returns the value of the named tag in tags
+func lookup(tags []Tag, name string) (string, bool) {
+	for _, tag := range tags {
+		if tag.Name == name {
+			return tag.Value, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,67 @@
+package notation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// TestSquares verifies algebraic squares convert to and from rows and columns
+func TestSquares(t *testing.T) {
+	tests := []struct {
+		square   string
+		row, col int
+	}{
+		{"a1", 0, 0},
+		{"b2", 1, 1},
+		{"c1", 0, 2},
+		{"a3", 2, 0},
+		{"o15", 14, 14},
+		{"z26", 25, 25},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.square, func(t *testing.T) {
+			if got := FormatSquare(tt.row, tt.col); got != tt.square {
+				t.Errorf("FormatSquare(%d, %d) = %q, want %q", tt.row, tt.col, got, tt.square)
+			}
+			row, col, err := ParseSquare(tt.square)
+			if err != nil || row != tt.row || col != tt.col {
+				t.Errorf("ParseSquare(%q) = %d, %d, %v; want %d, %d", tt.square, row, col, err, tt.row, tt.col)
+			}
+		})
+	}
+}
+
+// TestParseSquareRejectsMalformed verifies text that is not a square is a syntax error
+func TestParseSquareRejectsMalformed(t *testing.T) {
+	for _, s := range []string{"", "b", "2b", "B2", "b0", "b02", "b-1", "b+1", "b2x", "1 1"} {
+		t.Run(s, func(t *testing.T) {
+			if _, _, err := ParseSquare(s); !errors.Is(err, ErrSyntax) {
+				t.Errorf("ParseSquare(%q) error = %v, want ErrSyntax", s, err)
+			}
+		})
+	}
+}
+
+// TestResultOf verifies each game state's result notation
+func TestResultOf(t *testing.T) {
+	tests := []struct {
+		state game.GameState
+		want  string
+	}{
+		{game.InProgress, ResultUnfinished},
+		{game.Player1Won, ResultXWins},
+		{game.Player2Won, ResultOWins},
+		{game.Draw, ResultDraw},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.state.String(), func(t *testing.T) {
+			if got := ResultOf(tt.state); got != tt.want {
+				t.Errorf("ResultOf(%v) = %q, want %q", tt.state, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,308 @@
+package notation
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// parser reads notation one rune at a time, tracking the line and column
+type parser struct {
+	src    []rune
+	pos    int
+	line   int
+	column int
+	values map[string]position // Where each tag's value starts
+}
+
+// position is a 1-based line and column in the input
+type position struct {
+	line   int
+	column int
+}
+
+// Parse reads a single game record, replaying every move through
+// game.Game.MakeMove
+// Errors are *ParseError values locating the problem in the input
+func Parse(r io.Reader) (Record, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return Record{}, err
+	}
+	p := &parser{src: []rune(string(data)), line: 1, column: 1, values: make(map[string]position)}
+	return p.parseRecord()
+}
+
+// ParseString reads a single game record from s
+func ParseString(s string) (Record, error) {
+	return Parse(strings.NewReader(s))
+}
+
+// parseRecord parses the header and moves
+func (p *parser) parseRecord() (Record, error) {
+	var rec Record
+	p.skipSpace()
+	for p.peek() == '[' {
+		tag, err := p.parseTag(rec.Tags)
+		if err != nil {
+			return Record{}, err
+		}
+		rec.Tags = append(rec.Tags, tag)
+		p.skipSpace()
+	}
+
+	start, err := p.startPosition(rec.Tags)
+	if err != nil {
+		return Record{}, err
+	}
+	rec.Positions = []game.Game{start}
+
+	// Where the result was given, for diagnostics
+	resultLine, resultColumn := p.line, p.column
+	for !p.done() {
+		line, column := p.line, p.column
+		token := p.readToken()
+
+		if isResult(token) {
+			rec.Result, resultLine, resultColumn = token, line, column
+			p.skipSpace()
+			if !p.done() {
+				line, column := p.line, p.column
+				err := fmt.Errorf("%w: unexpected %q after the result", ErrSyntax, p.readToken())
+				return Record{}, &ParseError{Line: line, Column: column, Err: err}
+			}
+			break
+		}
+
+		square, column := stripMoveNumber(token, column)
+		if square == "" {
+			p.skipSpace()
+			continue
+		}
+		next, err := playSquare(rec.Final(), square)
+		if err != nil {
+			return Record{}, &ParseError{Line: line, Column: column, Err: err}
+		}
+		rec.Positions = append(rec.Positions, next)
+		p.skipSpace()
+	}
+
+	if err := checkResult(&rec); err != nil {
+		if errors.Is(err, ErrSyntax) {
+			return Record{}, p.tagError(TagResult, err)
+		}
+		return Record{}, &ParseError{Line: resultLine, Column: resultColumn, Err: err}
+	}
+	return rec, nil
+}
+
+// parseTag parses a [Name "value"] pair, rejecting names already in seen
+func (p *parser) parseTag(seen []Tag) (Tag, error) {
+	p.next() // [
+	p.skipBlanks()
+
+	line, column := p.line, p.column
+	var name strings.Builder
+	for r := p.peek(); r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r); r = p.peek() {
+		name.WriteRune(p.next())
+	}
+	if name.Len() == 0 {
+		return Tag{}, p.errorf(ErrSyntax, "expected a tag name")
+	}
+	if _, dup := lookup(seen, name.String()); dup {
+		return Tag{}, &ParseError{Line: line, Column: column, Err: fmt.Errorf("%w: duplicate tag %s", ErrSyntax, name.String())}
+	}
+
+	p.skipBlanks()
+	if p.peek() != '"' {
+		return Tag{}, p.errorf(ErrSyntax, "expected a quoted value for tag %s", name.String())
+	}
+	p.values[name.String()] = position{p.line, p.column}
+	p.next()
+
+	var value strings.Builder
+	for {
+		if p.done() || p.peek() == '\n' {
+			return Tag{}, p.errorf(ErrSyntax, "unterminated value for tag %s", name.String())
+		}
+		r := p.next()
+		if r == '"' {
+			break
+		}
+		if r == '\\' && (p.peek() == '"' || p.peek() == '\\') {
+			r = p.next()
+		}
+		value.WriteRune(r)
+	}
+
+	p.skipBlanks()
+	if p.peek() != ']' {
+		return Tag{}, p.errorf(ErrSyntax, "expected ] to close tag %s", name.String())
+	}
+	p.next()
+	return Tag{Name: name.String(), Value: value.String()}, nil
+}
+
+// startPosition builds the empty board described by the Board and First tags
+func (p *parser) startPosition(tags []Tag) (game.Game, error) {
+	config := game.StandardConfig()
+	if value, ok := lookup(tags, TagBoard); ok {
+		var err error
+		if config, err = game.ParseConfig(value); err != nil {
+			return game.Game{}, p.tagError(TagBoard, err)
+		}
+	}
+	g, err := game.NewGameWithConfig(config)
+	if err != nil {
+		return game.Game{}, p.tagError(TagBoard, err)
+	}
+
+	switch first, _ := lookup(tags, TagFirst); first {
+	case "", "X":
+	case "O":
+		g.CurrentPlayer = game.Player2
+	default:
+		return game.Game{}, p.tagError(TagFirst, fmt.Errorf("%w: First tag must be X or O, got %q", ErrSyntax, first))
+	}
+	return g, nil
+}
+
+// playSquare applies the move to the named square
+// A leading X or O names the mark to place, otherwise the mover's own is placed
+func playSquare(g game.Game, square string) (game.Game, error) {
+	mark, name := g.CurrentPlayer.GetMark(), square
+	switch {
+	case strings.HasPrefix(name, game.X.String()):
+		mark, name = game.X, name[1:]
+	case strings.HasPrefix(name, game.O.String()):
+		mark, name = game.O, name[1:]
+	}
+	row, col, err := ParseSquare(name)
+	if err != nil {
+		return g, err
+	}
+	if g.State != game.InProgress {
+		return g, fmt.Errorf("%w: %s is played after the game ended", ErrIllegalMove, square)
+	}
+	next, err := g.MakeMoveMark(row, col, mark)
+	if err != nil {
+		return g, fmt.Errorf("%w %s: %w", ErrIllegalMove, square, err)
+	}
+	return next, nil
+}
+
+// checkResult fills in a missing result from the Result tag and checks that
+// the result agrees with the tag and with the final position
+func checkResult(rec *Record) error {
+	tagged, hasTag := rec.Tag(TagResult)
+	if hasTag && !isResult(tagged) {
+		return fmt.Errorf("%w: Result tag %q is not 1-0, 0-1, 1/2-1/2 or *", ErrSyntax, tagged)
+	}
+
+	switch {
+	case rec.Result == "" && hasTag:
+		rec.Result = tagged
+	case rec.Result == "":
+		rec.Result = ResultUnfinished
+	case hasTag && tagged != rec.Result:
+		return fmt.Errorf("%w: moves end with %s but the Result tag is %s", ErrResultMismatch, rec.Result, tagged)
+	}
+
+	if actual := ResultOf(rec.Final().State); actual != rec.Result {
+		return fmt.Errorf("%w: recorded %s but the moves give %s", ErrResultMismatch, rec.Result, actual)
+	}
+	return nil
+}
+
+// stripMoveNumber removes a leading move number such as "12." or "3..." from
+// token, returning what remains and the column where it starts
+func stripMoveNumber(token string, column int) (string, int) {
+	digits := strings.IndexFunc(token, func(r rune) bool { return !unicode.IsDigit(r) })
+	if digits <= 0 || token[digits] != '.' {
+		return token, column
+	}
+	rest := strings.TrimLeft(token[digits:], ".")
+	return rest, column + len(token) - len(rest)
+}
+
+// isResult reports whether token is a game result
+func isResult(token string) bool {
+	switch token {
+	case ResultXWins, ResultOWins, ResultDraw, ResultUnfinished:
+		return true
+	default:
+		return false
+	}
+}
+
+// readToken reads up to the next space or comment
+func (p *parser) readToken() string {
+	var token strings.Builder
+	for !p.done() && !unicode.IsSpace(p.peek()) && p.peek() != '{' {
+		token.WriteRune(p.next())
+	}
+	return token.String()
+}
+
+// skipSpace skips whitespace and {comments}
+func (p *parser) skipSpace() {
+	for !p.done() {
+		switch r := p.peek(); {
+		case unicode.IsSpace(r):
+			p.next()
+		case r == '{':
+			for !p.done() && p.next() != '}' {
+			}
+		default:
+			return
+		}
+	}
+}
+
+// skipBlanks skips spaces and tabs within a line
+func (p *parser) skipBlanks() {
+	for p.peek() == ' ' || p.peek() == '\t' {
+		p.next()
+	}
+}
+
+// peek returns the next rune without consuming it, or 0 at the end
+func (p *parser) peek() rune {
+	if p.done() {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+// next consumes and returns the next rune
+func (p *parser) next() rune {
+	r := p.src[p.pos]
+	p.pos++
+	if r == '\n' {
+		p.line++
+		p.column = 1
+	} else {
+		p.column++
+	}
+	return r
+}
+
+// done reports whether all input has been consumed
+func (p *parser) done() bool {
+	return p.pos >= len(p.src)
+}
+
+// errorf returns a ParseError at the current position wrapping err
+func (p *parser) errorf(err error, format string, args ...any) *ParseError {
+	return &ParseError{Line: p.line, Column: p.column, Err: fmt.Errorf("%w: "+format, append([]any{err}, args...)...)}
+}
+
+// tagError returns a ParseError at the value of the named tag wrapping err
+func (p *parser) tagError(name string, err error) *ParseError {
+	pos := p.values[name]
+	return &ParseError{Line: pos.line, Column: pos.column, Err: err}
+}
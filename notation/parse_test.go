@@ -0,0 +1,134 @@
+package notation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// TestParseGame verifies a complete record is replayed into every position
+func TestParseGame(t *testing.T) {
+	input := `[Event "Club night"]
+[X "alice"]
+[O "Bob \"the builder\""]
+[Result "1-0"]
+
+{alice opens in the centre}
+1. b2 a1 2. c3 a3
+3. a2 b1 4.c2 1-0
+`
+	rec, err := ParseString(input)
+	if err != nil {
+		t.Fatalf("ParseString() returned error: %v", err)
+	}
+
+	if len(rec.Positions) != 8 {
+		t.Fatalf("Positions = %d, want start plus 7 moves", len(rec.Positions))
+	}
+	if got := rec.Positions[1].Board.GetCell(1, 1); got != game.X {
+		t.Errorf("After 1. b2 cell (1,1) = %v, want X", got)
+	}
+	if got := rec.Positions[2].Board.GetCell(0, 0); got != game.O {
+		t.Errorf("After 1... a1 cell (0,0) = %v, want O", got)
+	}
+	if rec.Final().State != game.Player1Won || rec.Result != ResultXWins {
+		t.Errorf("Final state = %v, result %q; want X to win", rec.Final().State, rec.Result)
+	}
+	if o, _ := rec.Tag(TagO); o != `Bob "the builder"` {
+		t.Errorf("Tag(O) = %q, want unescaped value", o)
+	}
+	if _, ok := rec.Tag(TagDate); ok {
+		t.Error("Tag(Date) found a tag that is not present")
+	}
+	if err := game.ValidatePosition(rec.Final()); err != nil {
+		t.Errorf("Final position is invalid: %v", err)
+	}
+}
+
+// TestParseVariants verifies the Board, First and Result tags and optional parts
+func TestParseVariants(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		wantConfig game.Config
+		wantFirst  game.Player
+		wantMoves  int
+		wantResult string
+	}{
+		{"Moves only", "b2 a1", game.StandardConfig(), game.Player1, 2, ResultUnfinished},
+		{"Empty record", "", game.StandardConfig(), game.Player1, 0, ResultUnfinished},
+		{"Result from tag", "[Result \"*\"]\n1. a1", game.StandardConfig(), game.Player1, 1, ResultUnfinished},
+		{"Bigger board", "[Board \"5x4/4\"]\n1. e4 a1", game.Config{Width: 5, Height: 4, WinLength: 4}, game.Player1, 2, ResultUnfinished},
+		{"O moves first", "[First \"O\"]\n1. b2 a1 *", game.StandardConfig(), game.Player2, 2, ResultUnfinished},
+		{"Draw", "a1 b2 c3 b1 b3 a3 c1 c2 a2 1/2-1/2", game.StandardConfig(), game.Player1, 9, ResultDraw},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec, err := ParseString(tt.input)
+			if err != nil {
+				t.Fatalf("ParseString() returned error: %v", err)
+			}
+			if rec.Positions[0].Config != tt.wantConfig {
+				t.Errorf("Config = %v, want %v", rec.Positions[0].Config, tt.wantConfig)
+			}
+			if rec.Positions[0].CurrentPlayer != tt.wantFirst {
+				t.Errorf("First player = %v, want %v", rec.Positions[0].CurrentPlayer, tt.wantFirst)
+			}
+			if got := len(rec.Positions) - 1; got != tt.wantMoves {
+				t.Errorf("Moves = %d, want %d", got, tt.wantMoves)
+			}
+			if rec.Result != tt.wantResult {
+				t.Errorf("Result = %q, want %q", rec.Result, tt.wantResult)
+			}
+		})
+	}
+}
+
+// TestParseDiagnostics verifies malformed input is reported with its line and column
+func TestParseDiagnostics(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		wantLine   int
+		wantColumn int
+		wantErr    error
+	}{
+		{"Not a square", "1. b2 zz", 1, 7, ErrSyntax},
+		{"Move number attached", "1. b2 a1\n2.9q", 2, 3, ErrSyntax},
+		{"Occupied square", "1. b2 a1\n2. b2", 2, 4, game.ErrCellOccupied},
+		{"Off the board", "1. d1", 1, 4, game.ErrInvalidRange},
+		{"Illegal move wraps", "1. d1", 1, 4, ErrIllegalMove},
+		{"Move after the end", "a1 b1 a2 b2 a3 c3", 1, 16, ErrIllegalMove},
+		{"Text after result", "a1 * b2", 1, 6, ErrSyntax},
+		{"Unterminated tag value", "[Event \"club\n", 1, 13, ErrSyntax},
+		{"Missing tag value", "[Event club]", 1, 8, ErrSyntax},
+		{"Unclosed tag", "[Event \"club\" x]", 1, 15, ErrSyntax},
+		{"Duplicate tag", "[X \"a\"]\n[X \"b\"]", 2, 2, ErrSyntax},
+		{"Bad board", "[Event \"e\"]\n[Board \"3x3\"]", 2, 8, game.ErrInvalidConfig},
+		{"Bad first player", "[First \"Z\"]", 1, 8, ErrSyntax},
+		{"Bad result tag", "[Result \"X wins\"]", 1, 9, ErrSyntax},
+		{"Result tag disagrees", "[Result \"0-1\"]\n\na1 b1 a2 b2 a3 1-0", 3, 16, ErrResultMismatch},
+		{"Result disagrees with moves", "a1 b1 a2 b2 a3 0-1", 1, 16, ErrResultMismatch},
+		{"Finished game marked unfinished", "a1 b1 a2 b2 a3 *", 1, 16, ErrResultMismatch},
+		{"Opponent's mark outside wild", "1. b2 Xa1", 1, 7, game.ErrWrongMark},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseString(tt.input)
+			var perr *ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("ParseString() error = %v, want a *ParseError", err)
+			}
+			if perr.Line != tt.wantLine || perr.Column != tt.wantColumn {
+				t.Errorf("Position = line %d, column %d; want line %d, column %d (%v)",
+					perr.Line, perr.Column, tt.wantLine, tt.wantColumn, err)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseString() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package notation
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// maxLineLength is the width at which the move list is wrapped
+const maxLineLength = 79
+
+// Write emits the record of g, which must include its full move history
+// tags supplies descriptive tags such as Event, Date, X and O in the order
+// given; the Board, First and Result tags are always derived from g
+func Write(w io.Writer, g game.Game, tags []Tag) error {
+	if len(g.History) != g.MoveCount {
+		return fmt.Errorf("%w: %d of %d moves recorded", ErrIncompleteHistory, len(g.History), g.MoveCount)
+	}
+
+	var b strings.Builder
+	for _, tag := range recordTags(g, tags) {
+		fmt.Fprintf(&b, "[%s %s]\n", tag.Name, quote(tag.Value))
+	}
+	b.WriteString("\n")
+	b.WriteString(moveText(g))
+	b.WriteString("\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// Format returns the record of g as a string; see Write
+func Format(g game.Game, tags []Tag) (string, error) {
+	var b strings.Builder
+	if err := Write(&b, g, tags); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// recordTags returns tags without any derived tags, followed by the derived ones
+func recordTags(g game.Game, tags []Tag) []Tag {
+	var out []Tag
+	for _, tag := range tags {
+		switch tag.Name {
+		case TagBoard, TagFirst, TagResult:
+		default:
+			out = append(out, tag)
+		}
+	}
+
+	out = append(out, Tag{TagBoard, g.Config.String()})
+	if firstPlayer(g) == game.Player2 {
+		out = append(out, Tag{TagFirst, "O"})
+	}
+	return append(out, Tag{TagResult, ResultOf(g.State)})
+}
+
+// firstPlayer returns who made the first move of g, or who is to make it
+func firstPlayer(g game.Game) game.Player {
+	if len(g.History) > 0 {
+		return g.History[0].Player
+	}
+	return g.CurrentPlayer
+}
+
+// moveText returns the numbered moves followed by the result, wrapped at maxLineLength
+func moveText(g game.Game) string {
+	// A move number stays on the same line as the move it numbers
+	var tokens []string
+	for i, move := range g.History {
+		square := FormatSquare(move.Row, move.Col)
+		if g.Config.Variant == game.Wild {
+			square = move.Mark.String() + square
+		}
+		if i%2 == 0 {
+			square = strconv.Itoa(i/2+1) + ". " + square
+		}
+		tokens = append(tokens, square)
+	}
+	tokens = append(tokens, ResultOf(g.State))
+
+	var b strings.Builder
+	lineLength := 0
+	for i, token := range tokens {
+		if i > 0 {
+			if lineLength+1+len(token) > maxLineLength {
+				b.WriteString("\n")
+				lineLength = 0
+			} else {
+				b.WriteString(" ")
+				lineLength++
+			}
+		}
+		b.WriteString(token)
+		lineLength += len(token)
+	}
+	return b.String()
+}
+
+// quote returns value in double quotes with quotes and backslashes escaped
+func quote(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	return `"` + strings.ReplaceAll(value, `"`, `\"`) + `"`
+}
@@ -0,0 +1,127 @@
+package notation
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// play makes each move in turn, failing the test on an illegal move
+// Squares may name the mark to place, as in "Ob2"
+func play(t *testing.T, g game.Game, moves ...string) game.Game {
+	t.Helper()
+	for _, square := range moves {
+		if g.State != game.InProgress {
+			t.Fatalf("Move %s played after the game ended", square)
+		}
+		var err error
+		if g, err = playSquare(g, square); err != nil {
+			t.Fatalf("playSquare(%s) returned error: %v", square, err)
+		}
+	}
+	return g
+}
+
+// TestWriteRecord verifies the exact text written for a finished game
+func TestWriteRecord(t *testing.T) {
+	g := play(t, game.NewGame(), "b2", "a1", "c3", "a3", "a2", "b1", "c2")
+	tags := []Tag{{TagEvent, "Club night"}, {TagX, "alice"}, {TagO, `Bob "B"`}, {TagResult, "*"}}
+
+	got, err := Format(g, tags)
+	if err != nil {
+		t.Fatalf("Format() returned error: %v", err)
+	}
+	want := `[Event "Club night"]
+[X "alice"]
+[O "Bob \"B\""]
+[Board "3x3/3"]
+[Result "1-0"]
+
+1. b2 a1 2. c3 a3 3. a2 b1 4. c2 1-0
+`
+	if got != want {
+		t.Errorf("Format() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+// TestWriteParseRoundTrip verifies parsing a written record reproduces the game
+func TestWriteParseRoundTrip(t *testing.T) {
+	big, _ := game.NewGameWithConfig(game.Config{Width: 26, Height: 26, WinLength: 5})
+	var scattered []string
+	for row := 0; row < 26; row += 2 {
+		for col := 0; col < 26; col += 3 {
+			scattered = append(scattered, FormatSquare(row, col))
+		}
+	}
+	oFirst := game.NewGame()
+	oFirst.CurrentPlayer = game.Player2
+	misere, _ := game.NewGameWithConfig(game.Config{Width: 3, Height: 3, WinLength: 3, Variant: game.Misere})
+	wild, _ := game.NewGameWithConfig(game.Config{Width: 3, Height: 3, WinLength: 3, Variant: game.Wild})
+
+	tests := []struct {
+		name  string
+		start game.Game
+		moves []string
+	}{
+		{"Unfinished", game.NewGame(), []string{"b2", "a1"}},
+		{"No moves", game.NewGame(), nil},
+		{"Draw", game.NewGame(), []string{"a1", "b2", "c3", "b1", "b3", "a3", "c1", "c2", "a2"}},
+		{"O moves first", oFirst, []string{"a1", "b2", "a2", "c3", "a3"}},
+		{"Long game wraps", big, scattered},
+		{"Misere line loses", misere, []string{"a1", "a2", "b1", "b2", "c1"}},
+		{"Wild line of the opponent's mark", wild, []string{"Xa1", "Ob2", "Xb1", "Xc1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := play(t, tt.start, tt.moves...)
+			text, err := Format(g, []Tag{{TagDate, "2026.10.17"}})
+			if err != nil {
+				t.Fatalf("Format() returned error: %v", err)
+			}
+			for _, line := range strings.Split(text, "\n") {
+				if len(line) > maxLineLength {
+					t.Errorf("Line %q is longer than %d", line, maxLineLength)
+				}
+			}
+
+			rec, err := ParseString(text)
+			if err != nil {
+				t.Fatalf("ParseString() returned error: %v\n%s", err, text)
+			}
+			final := rec.Final()
+			if !final.Board.Equal(g.Board) || final.State != g.State || final.CurrentPlayer != g.CurrentPlayer {
+				t.Errorf("Parsed final position differs:\n%s", text)
+			}
+			if date, _ := rec.Tag(TagDate); date != "2026.10.17" {
+				t.Errorf("Tag(Date) = %q, want 2026.10.17", date)
+			}
+		})
+	}
+}
+
+// TestWriteWildMarks verifies wild moves are written with the mark placed
+func TestWriteWildMarks(t *testing.T) {
+	wild, _ := game.NewGameWithConfig(game.Config{Width: 3, Height: 3, WinLength: 3, Variant: game.Wild})
+	g := play(t, wild, "Xa1", "Ob2", "Xb1", "Xc1")
+
+	got, err := Format(g, nil)
+	if err != nil {
+		t.Fatalf("Format() returned error: %v", err)
+	}
+	if !strings.HasSuffix(got, "\n1. Xa1 Ob2 2. Xb1 Xc1 0-1\n") {
+		t.Errorf("Format() =\n%s\nwant the moves 1. Xa1 Ob2 2. Xb1 Xc1 0-1", got)
+	}
+}
+
+// TestWriteRequiresFullHistory verifies games without their full history cannot be written
+func TestWriteRequiresFullHistory(t *testing.T) {
+	g := play(t, game.NewGame(), "b2", "a1")
+	g.History = g.History[1:]
+
+	if _, err := Format(g, nil); !errors.Is(err, ErrIncompleteHistory) {
+		t.Errorf("Format() error = %v, want ErrIncompleteHistory", err)
+	}
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+	"github.com/YOUR_USERNAME/tictactoe/game/numerical"
+)
+
+// errNumericalArgs indicates numerical was given positional arguments
+var errNumericalArgs = errors.New("usage: tictactoe numerical")
+
+// runNumerical implements `tictactoe numerical`: a two-player game of Numerical Tic-Tac-Toe
+func runNumerical(args []string) error {
+	fs := flag.NewFlagSet("numerical", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		return errNumericalArgs
+	}
+
+	fmt.Println("=== Numerical Tic-Tac-Toe ===")
+	fmt.Println("Player 1 places odd numbers and Player 2 even; each number is used once")
+	fmt.Printf("Complete a line of three numbers summing to %d to win\n", numerical.Target)
+	g := runNumericalGame(numerical.NewGame(), bufio.NewScanner(os.Stdin))
+
+	displayNumbers(g.Board)
+	displayNumericalResult(g.State)
+	return nil
+}
+
+// runNumericalGame reads moves from scanner until the game ends or input runs out
+func runNumericalGame(g numerical.Game, scanner *bufio.Scanner) numerical.Game {
+	for g.State == game.InProgress {
+		displayNumbers(g.Board)
+		fmt.Printf("%s, numbers left: %s\n", numerical.PlayerName(g.CurrentPlayer), formatNumbers(g.Available(g.CurrentPlayer)))
+		fmt.Print("Enter a number, then row and column (0-2), e.g., '5 at 1 1': ")
+
+		if !scanner.Scan() {
+			break
+		}
+		n, p, err := numerical.ParseMove(scanner.Text())
+		if err != nil {
+			displayError(err)
+			continue
+		}
+		next, err := g.MakeMove(n, p)
+		if err != nil {
+			displayError(err)
+			continue
+		}
+		g = next
+	}
+	return g
+}
+
+// numericalResult describes the outcome of a finished numerical game, naming
+// the winner by the numbers they placed
+func numericalResult(state game.GameState) string {
+	switch state {
+	case game.Player1Won:
+		return "🎉 " + numerical.PlayerName(game.Player1) + " wins!"
+	case game.Player2Won:
+		return "🎉 " + numerical.PlayerName(game.Player2) + " wins!"
+	case game.Draw:
+		return "It's a draw!"
+	default:
+		return ""
+	}
+}
+
+// displayNumericalResult announces the outcome of a finished numerical game
+func displayNumericalResult(state game.GameState) {
+	if result := numericalResult(state); result != "" {
+		fmt.Println(result)
+	}
+}
+
+// formatNumbers lists numbers separated by spaces
+func formatNumbers(numbers []int) string {
+	words := make([]string, len(numbers))
+	for i, n := range numbers {
+		words[i] = strconv.Itoa(n)
+	}
+	return strings.Join(words, " ")
+}
+
+// displayNumbers prints the numbers on the board with row and column numbers
+func displayNumbers(board numerical.Board) {
+	fmt.Println()
+	fmt.Println("  0   1   2")
+	for row, cells := range board {
+		fmt.Printf("%d ", row)
+		for col, cell := range cells {
+			fmt.Printf(" %s ", cell)
+			if col < numerical.Size-1 {
+				fmt.Print("|")
+			}
+		}
+		fmt.Println()
+		if row < numerical.Size-1 {
+			fmt.Println("  " + strings.Repeat("-", 4*numerical.Size-1))
+		}
+	}
+	fmt.Println()
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+	"github.com/YOUR_USERNAME/tictactoe/game/numerical"
+)
+
+// TestRunNumericalArgs verifies the numerical subcommand takes no positional arguments
+func TestRunNumericalArgs(t *testing.T) {
+	if err := runNumerical([]string{"extra"}); !errors.Is(err, errNumericalArgs) {
+		t.Errorf("runNumerical([extra]) error = %v, want errNumericalArgs", err)
+	}
+}
+
+// TestRunNumericalGame plays a scripted game where Player 2 completes a diagonal summing to 15
+func TestRunNumericalGame(t *testing.T) {
+	input := strings.Join([]string{
+		"1 at 0 0",
+		"8",        // Incomplete
+		"3 at 1 1", // Odd number for Player 2
+		"8 at 3 3", // Off the board
+		"8 at 0 0", // Occupied
+		"8 at 1 1",
+		"1 at 2 0", // Already used
+		"3 at 0 2",
+		"6 at 2 2", // 1 + 8 + 6 wins for Player 2
+		"5 at 1 0", // Never read
+	}, "\n")
+	scanner := bufio.NewScanner(strings.NewReader(input))
+	g := runNumericalGame(numerical.NewGame(), scanner)
+
+	if g.State != game.Player2Won || g.MoveCount != 4 {
+		t.Errorf("State = %v after %d moves, want Player 2 won after 4", g.State, g.MoveCount)
+	}
+	if !scanner.Scan() || scanner.Text() != "5 at 1 0" {
+		t.Error("runNumericalGame() read past the end of the game")
+	}
+}
+
+// TestNumericalResult verifies winners are announced by parity rather than X or O
+func TestNumericalResult(t *testing.T) {
+	tests := []struct {
+		state game.GameState
+		want  string
+	}{
+		{game.Player1Won, "🎉 Player 1 (odd) wins!"},
+		{game.Player2Won, "🎉 Player 2 (even) wins!"},
+		{game.Draw, "It's a draw!"},
+		{game.InProgress, ""},
+	}
+	for _, tt := range tests {
+		if got := numericalResult(tt.state); got != tt.want {
+			t.Errorf("numericalResult(%v) = %q, want %q", tt.state, got, tt.want)
+		}
+	}
+}
+
+// TestFormatNumbers verifies the list of numbers left is space separated
+func TestFormatNumbers(t *testing.T) {
+	if got := formatNumbers([]int{1, 3, 9}); got != "1 3 9" {
+		t.Errorf("formatNumbers() = %q, want %q", got, "1 3 9")
+	}
+	if got := formatNumbers(nil); got != "" {
+		t.Errorf("formatNumbers(nil) = %q, want empty", got)
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+	"github.com/YOUR_USERNAME/tictactoe/game/qubic"
+)
+
+// errQubicArgs indicates qubic was given positional arguments
+var errQubicArgs = errors.New("usage: tictactoe qubic")
+
+// runQubic implements `tictactoe qubic`: a two-player game of 3D tic-tac-toe on a 4x4x4 cube
+func runQubic(args []string) error {
+	fs := flag.NewFlagSet("qubic", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		return errQubicArgs
+	}
+
+	fmt.Println("=== Qubic: 3D Tic-Tac-Toe ===")
+	fmt.Println("Get four in a row along any row, column, pillar or diagonal of the cube")
+	g := runQubicGame(qubic.NewGame(), bufio.NewScanner(os.Stdin))
+
+	displayCube(g)
+	displayResult(g.State)
+	return nil
+}
+
+// runQubicGame reads moves from scanner until the game ends or input runs out
+func runQubicGame(g qubic.Game, scanner *bufio.Scanner) qubic.Game {
+	for g.State == game.InProgress {
+		displayCube(g)
+		fmt.Printf("%s, enter layer, row and column (0-3), e.g., '1 2 3': ", g.CurrentPlayer.Name())
+
+		if !scanner.Scan() {
+			break
+		}
+		p, err := qubic.ParseMove(scanner.Text())
+		if err != nil {
+			displayError(err)
+			continue
+		}
+		next, err := g.MakeMove(p)
+		if err != nil {
+			displayError(err)
+			continue
+		}
+		g = next
+	}
+	return g
+}
+
+// displayCube prints the cube as its layers stacked from top to bottom
+func displayCube(g qubic.Game) {
+	for layer, board := range g.Layers {
+		fmt.Printf("\nLayer %d", layer)
+		displayBoard(board)
+	}
+}
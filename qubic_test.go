@@ -0,0 +1,53 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+	"github.com/YOUR_USERNAME/tictactoe/game/qubic"
+)
+
+// TestRunQubicArgs verifies the qubic subcommand takes no positional arguments
+func TestRunQubicArgs(t *testing.T) {
+	if err := runQubic([]string{"extra"}); !errors.Is(err, errQubicArgs) {
+		t.Errorf("runQubic([extra]) error = %v, want errQubicArgs", err)
+	}
+}
+
+// TestRunQubicGame plays a scripted game where X completes a space diagonal
+func TestRunQubicGame(t *testing.T) {
+	input := strings.Join([]string{
+		"0 0 0",
+		"1 1",   // Incomplete
+		"0 0 4", // Out of range
+		"0 0 0", // Occupied
+		"0 1 0",
+		"1 1 1",
+		"0 2 0",
+		"2 2 2",
+		"0 3 3",
+		"3 3 3", // X wins
+		"1 0 0", // Never read
+	}, "\n")
+	scanner := bufio.NewScanner(strings.NewReader(input))
+	g := runQubicGame(qubic.NewGame(), scanner)
+
+	if g.State != game.Player1Won || g.MoveCount != 7 {
+		t.Errorf("State = %v after %d moves, want X won after 7", g.State, g.MoveCount)
+	}
+	if !scanner.Scan() || scanner.Text() != "1 0 0" {
+		t.Error("runQubicGame() read past the end of the game")
+	}
+}
+
+// TestRunQubicGameStopsWhenInputEnds verifies an unfinished game is returned as it stands
+func TestRunQubicGameStopsWhenInputEnds(t *testing.T) {
+	g := runQubicGame(qubic.NewGame(), bufio.NewScanner(strings.NewReader("3 0 1\n")))
+
+	if g.State != game.InProgress || g.MoveCount != 1 || g.Cell(qubic.Position{Layer: 3, Row: 0, Col: 1}) != game.X {
+		t.Errorf("Game = %v after %d moves, want one X move in progress", g.State, g.MoveCount)
+	}
+}
@@ -0,0 +1,125 @@
+// Package rating computes Elo ratings from recorded game results
+//
+// Ratings are never stored: they are recomputed by replaying the recorded
+// results in order, so the same results always produce the same ratings.
+// Computer opponents are rated like human profiles under their recorded
+// names, e.g. "Computer (perfect)".
+package rating
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+	"github.com/YOUR_USERNAME/tictactoe/stats"
+)
+
+const (
+	// InitialRating is the rating of a player before their first game
+	InitialRating = 1500.0
+	// KFactor is the largest rating change a single game can cause
+	KFactor = 32.0
+	// scale is the rating difference at which the stronger player is expected
+	// to score ten times as much as the weaker one
+	scale = 400.0
+)
+
+// Rating is a player's current Elo rating
+type Rating struct {
+	Name   string
+	Rating float64
+	Games  int // Rated games played
+}
+
+// Expected returns the score a player rated a is expected to make against a
+// player rated b, between 0 and 1
+func Expected(a, b float64) float64 {
+	return 1 / (1 + math.Pow(10, (b-a)/scale))
+}
+
+// Scores returns the points X and O earn for a finished game:
+// 1 for a win, 0 for a loss and 0.5 each for a draw
+func Scores(result game.GameState) (x, o float64) {
+	switch result {
+	case game.Player1Won:
+		return 1, 0
+	case game.Player2Won:
+		return 0, 1
+	default:
+		return 0.5, 0.5
+	}
+}
+
+// Update returns the new ratings of two players rated a and b after a game in
+// which the first scored scoreA
+func Update(a, b, scoreA float64) (float64, float64) {
+	delta := KFactor * (scoreA - Expected(a, b))
+	return a + delta, b - delta
+}
+
+// Table tracks every player's rating as results are applied
+// The zero value is not ready for use; call NewTable
+type Table struct {
+	players map[string]*Rating
+}
+
+// NewTable returns a table in which every player starts at InitialRating
+func NewTable() *Table {
+	return &Table{players: make(map[string]*Rating)}
+}
+
+// Apply updates both players' ratings with the result of rec
+// Unfinished games are ignored
+func (t *Table) Apply(rec stats.Record) {
+	if rec.Result == game.InProgress {
+		return
+	}
+	x, o := t.player(rec.X), t.player(rec.O)
+	scoreX, _ := Scores(rec.Result)
+	x.Rating, o.Rating = Update(x.Rating, o.Rating, scoreX)
+	x.Games++
+	o.Games++
+}
+
+// Get returns the named player's rating, or InitialRating if they have not played
+func (t *Table) Get(name string) Rating {
+	if r, ok := t.players[name]; ok {
+		return *r
+	}
+	return Rating{Name: name, Rating: InitialRating}
+}
+
+// Leaderboard returns every rated player from highest to lowest rating,
+// breaking ties by name
+func (t *Table) Leaderboard() []Rating {
+	board := make([]Rating, 0, len(t.players))
+	for _, r := range t.players {
+		board = append(board, *r)
+	}
+	slices.SortFunc(board, func(a, b Rating) int {
+		if c := cmp.Compare(b.Rating, a.Rating); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return board
+}
+
+// player returns the named player's entry, creating it at InitialRating
+func (t *Table) player(name string) *Rating {
+	if t.players[name] == nil {
+		t.players[name] = &Rating{Name: name, Rating: InitialRating}
+	}
+	return t.players[name]
+}
+
+// Compute replays records, which must be in the order the games were played,
+// and returns the resulting table
+func Compute(records []stats.Record) *Table {
+	t := NewTable()
+	for _, rec := range records {
+		t.Apply(rec)
+	}
+	return t
+}
@@ -0,0 +1,137 @@
+package rating
+
+import (
+	"math"
+	"testing"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+	"github.com/YOUR_USERNAME/tictactoe/stats"
+)
+
+// near reports whether a and b agree to within a hundredth of a point
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 0.01
+}
+
+// TestExpected verifies expected scores for equal and unequal ratings
+func TestExpected(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b float64
+		want float64
+	}{
+		{"Equal ratings", 1500, 1500, 0.5},
+		{"400 points stronger", 1900, 1500, 10.0 / 11},
+		{"400 points weaker", 1500, 1900, 1.0 / 11},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Expected(tt.a, tt.b); !near(got, tt.want) {
+				t.Errorf("Expected(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestScores verifies the points each side earns for every finished state
+func TestScores(t *testing.T) {
+	tests := []struct {
+		result game.GameState
+		x, o   float64
+	}{
+		{game.Player1Won, 1, 0},
+		{game.Player2Won, 0, 1},
+		{game.Draw, 0.5, 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.result.String(), func(t *testing.T) {
+			if x, o := Scores(tt.result); x != tt.x || o != tt.o {
+				t.Errorf("Scores(%v) = %v, %v; want %v, %v", tt.result, x, o, tt.x, tt.o)
+			}
+		})
+	}
+}
+
+// TestUpdate verifies rating changes are zero-sum and scaled by surprise
+func TestUpdate(t *testing.T) {
+	tests := []struct {
+		name         string
+		a, b, scoreA float64
+		wantA, wantB float64
+	}{
+		{"Equal players, first wins", 1500, 1500, 1, 1516, 1484},
+		{"Equal players draw", 1500, 1500, 0.5, 1500, 1500},
+		{"Favourite wins", 1900, 1500, 1, 1900 + 32.0/11, 1500 - 32.0/11},
+		{"Underdog wins", 1500, 1900, 1, 1500 + 320.0/11, 1900 - 320.0/11},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := Update(tt.a, tt.b, tt.scoreA)
+			if !near(a, tt.wantA) || !near(b, tt.wantB) {
+				t.Errorf("Update() = %v, %v; want %v, %v", a, b, tt.wantA, tt.wantB)
+			}
+			if !near(a+b, tt.a+tt.b) {
+				t.Errorf("Update() changed total rating from %v to %v", tt.a+tt.b, a+b)
+			}
+		})
+	}
+}
+
+// TestCompute verifies ratings replayed from recorded results
+func TestCompute(t *testing.T) {
+	records := []stats.Record{
+		{X: "alice", O: "Computer (random)", Result: game.Player1Won},
+		{X: "Computer (perfect)", O: "alice", Result: game.Draw},
+		{X: "bob", O: "alice", Result: game.Player2Won},
+		{X: "bob", O: "carol", Result: game.InProgress}, // Never finished: not rated
+	}
+
+	table := Compute(records)
+	board := table.Leaderboard()
+
+	names := []string{"alice", "Computer (perfect)", "bob", "Computer (random)"}
+	if len(board) != len(names) {
+		t.Fatalf("Leaderboard() has %d players, want %d: %+v", len(board), len(names), board)
+	}
+	for i, name := range names {
+		if board[i].Name != name {
+			t.Errorf("Leaderboard()[%d] = %s, want %s", i, board[i].Name, name)
+		}
+	}
+
+	// alice: beat an equal player (+16), then drew a lower-rated one and beat an equal one
+	alice := table.Get("alice")
+	afterDraw := 1516 + KFactor*(0.5-Expected(1516, 1500))
+	wantAlice := afterDraw + KFactor*(1-Expected(afterDraw, 1500))
+	if !near(alice.Rating, wantAlice) || alice.Games != 3 {
+		t.Errorf("alice = %+v, want rating %v after 3 games", alice, wantAlice)
+	}
+
+	if carol := table.Get("carol"); carol.Rating != InitialRating || carol.Games != 0 {
+		t.Errorf("Unrated carol = %+v, want initial rating", carol)
+	}
+}
+
+// TestComputeIsDeterministic verifies the same results always give the same ratings
+func TestComputeIsDeterministic(t *testing.T) {
+	var records []stats.Record
+	results := []game.GameState{game.Player1Won, game.Draw, game.Player2Won}
+	players := []string{"alice", "bob", "carol", "Computer (greedy)"}
+	for i := range 200 {
+		records = append(records, stats.Record{
+			X:      players[i%len(players)],
+			O:      players[(i+1+(i/len(players))%(len(players)-1))%len(players)],
+			Result: results[i%len(results)],
+		})
+	}
+
+	first, second := Compute(records).Leaderboard(), Compute(records).Leaderboard()
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("Leaderboard()[%d] differs between runs: %+v vs %+v", i, first[i], second[i])
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+	"github.com/YOUR_USERNAME/tictactoe/notation"
+)
+
+// defaultReplayDelay is the pause between moves during autoplay
+const defaultReplayDelay = time.Second
+
+// Replay errors
+var (
+	// errReplayFile indicates replay was run without exactly one file
+	errReplayFile = errors.New("usage: tictactoe replay [-delay 1s] <file>")
+
+	// errReplayCommand indicates an unrecognised command at the replay prompt
+	errReplayCommand = errors.New("unknown command: use next, back, jump <n>, auto or quit")
+
+	// errReplayRange indicates a jump to a move that is not in the game
+	errReplayRange = errors.New("no such move in this game")
+)
+
+// replayer steps through the positions of a recorded game
+type replayer struct {
+	positions []game.Game   // positions[0] is the start, positions[i] the position after move i
+	index     int           // Position currently shown
+	delay     time.Duration // Pause between moves during autoplay
+	sleep     func(time.Duration)
+}
+
+// runReplay implements `tictactoe replay <file>`: step through a saved or notated game
+func runReplay(args []string) error {
+	fs := flag.NewFlagSet("replay", flag.ContinueOnError)
+	delay := fs.Duration("delay", defaultReplayDelay, "pause between moves during autoplay")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errReplayFile
+	}
+
+	positions, err := replayPositions(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("=== Tic-Tac-Toe (replay) ===")
+	r := &replayer{positions: positions, delay: *delay, sleep: time.Sleep}
+	r.run(bufio.NewScanner(os.Stdin))
+	return nil
+}
+
+// replayPositions reconstructs every position of the game in path, which is
+// game notation if it ends in .ttn and a JSON save otherwise
+func replayPositions(path string) ([]game.Game, error) {
+	if filepath.Ext(path) == notationExt {
+		rec, err := readNotation(path)
+		if err != nil {
+			return nil, err
+		}
+		return rec.Positions, nil
+	}
+
+	g, err := game.LoadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return historyPositions(g)
+}
+
+// historyPositions rewinds g to the start of its history and replays each
+// recorded move through MakeMoveMark
+func historyPositions(g game.Game) ([]game.Game, error) {
+	moves := g.History
+	start := g
+	for start.CanUndo() {
+		start, _ = start.Undo()
+	}
+	start.Undone = nil
+
+	positions := []game.Game{start}
+	for _, move := range moves {
+		next, err := positions[len(positions)-1].MakeMoveMark(move.Row, move.Col, move.Mark)
+		if err != nil {
+			return nil, err
+		}
+		positions = append(positions, next)
+	}
+	return positions, nil
+}
+
+// run shows the start position and follows commands until quit or input runs out
+func (r *replayer) run(scanner *bufio.Scanner) {
+	r.show()
+	for {
+		fmt.Println("Commands: next (Enter), back, jump <n>, auto, quit")
+		fmt.Print("> ")
+		if !scanner.Scan() {
+			return
+		}
+		if quit := r.handle(scanner.Text()); quit {
+			return
+		}
+	}
+}
+
+// handle runs one replay command and reports whether the replay should end
+func (r *replayer) handle(input string) bool {
+	fields := strings.Fields(strings.ToLower(input))
+	if len(fields) == 0 {
+		fields = []string{"next"}
+	}
+
+	switch fields[0] {
+	case "n", "next":
+		r.jump(r.index + 1)
+	case "b", "back":
+		r.jump(r.index - 1)
+	case "j", "jump":
+		if len(fields) != 2 {
+			displayError(errReplayCommand)
+			return false
+		}
+		n, err := strconv.Atoi(fields[1])
+		if err != nil {
+			displayError(errReplayCommand)
+			return false
+		}
+		r.jump(n)
+	case "a", "auto":
+		r.autoplay()
+	case "q", "quit":
+		return true
+	default:
+		if n, err := strconv.Atoi(fields[0]); err == nil {
+			r.jump(n)
+			return false
+		}
+		displayError(errReplayCommand)
+	}
+	return false
+}
+
+// jump shows the position after move n
+func (r *replayer) jump(n int) {
+	if n < 0 || n >= len(r.positions) {
+		displayError(fmt.Errorf("%w: moves run from 0 to %d", errReplayRange, len(r.positions)-1))
+		return
+	}
+	r.index = n
+	r.show()
+}
+
+// autoplay shows each remaining move in turn, pausing between them
+func (r *replayer) autoplay() {
+	for r.index < len(r.positions)-1 {
+		r.sleep(r.delay)
+		r.index++
+		r.show()
+	}
+}
+
+// show displays the current position as it looked during play
+func (r *replayer) show() {
+	g := r.positions[r.index]
+	last := len(r.positions) - 1
+	if r.index == 0 {
+		fmt.Printf("\nStart of game (%d moves)\n", last)
+	} else {
+		fmt.Printf("\nMove %d of %d: %s\n", r.index, last, describeMove(g.Config, g.History[len(g.History)-1]))
+	}
+
+	displayBoard(g.Board)
+	if g.State != game.InProgress {
+		displayResult(g.State)
+	} else if r.index == last {
+		fmt.Println("End of recording")
+	}
+}
+
+// describeMove names the player and square of move, e.g. "Player 1 (X) plays 1 1 (b2)"
+// Wild moves also name the mark placed, as notation does, e.g. "Player 1 (X) plays O at 1 1 (Ob2)"
+func describeMove(config game.Config, move game.Move) string {
+	square := notation.FormatSquare(move.Row, move.Col)
+	if config.Variant == game.Wild {
+		return fmt.Sprintf("%s plays %s at %d %d (%s%s)", move.Player.Name(), move.Mark, move.Row, move.Col, move.Mark, square)
+	}
+	return fmt.Sprintf("%s plays %d %d (%s)", move.Player.Name(), move.Row, move.Col, square)
+}
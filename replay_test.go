@@ -0,0 +1,185 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// replayTestGame returns a standard game after X wins along the top row
+func replayTestGame(t *testing.T) game.Game {
+	t.Helper()
+	g := game.NewGame()
+	for _, m := range [][2]int{{0, 0}, {1, 0}, {0, 1}, {1, 1}, {0, 2}} {
+		var err error
+		if g, err = g.MakeMove(m[0], m[1]); err != nil {
+			t.Fatalf("MakeMove(%d, %d) returned error: %v", m[0], m[1], err)
+		}
+	}
+	return g
+}
+
+// TestReplayPositions verifies JSON saves and notation files replay to the same positions
+func TestReplayPositions(t *testing.T) {
+	dir := t.TempDir()
+	g := replayTestGame(t)
+
+	for _, name := range []string{"game.json", "game.ttn"} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(dir, name)
+			if err := saveFile(path, g); err != nil {
+				t.Fatalf("saveFile() returned error: %v", err)
+			}
+
+			positions, err := replayPositions(path)
+			if err != nil {
+				t.Fatalf("replayPositions() returned error: %v", err)
+			}
+			if len(positions) != 6 {
+				t.Fatalf("replayPositions() returned %d positions, want 6", len(positions))
+			}
+			if positions[0].MoveCount != 0 || !positions[0].Board.Equal(game.NewBoard()) {
+				t.Errorf("First position = %+v, want an empty board", positions[0])
+			}
+			if final := positions[5]; !final.Board.Equal(g.Board) || final.State != game.Player1Won {
+				t.Errorf("Final position = %+v, want X's win", final)
+			}
+		})
+	}
+
+	if _, err := replayPositions(filepath.Join(dir, "missing.ttn")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("replayPositions(missing) error = %v, want ErrNotExist", err)
+	}
+}
+
+// TestReplayPositionsWild verifies wild games replay with the marks that were placed
+func TestReplayPositionsWild(t *testing.T) {
+	g, err := game.NewGameWithConfig(game.Config{Width: 3, Height: 3, WinLength: 3, Variant: game.Wild})
+	if err != nil {
+		t.Fatalf("NewGameWithConfig() returned error: %v", err)
+	}
+	// X wins by completing a diagonal of O
+	for _, m := range []game.Move{{Row: 0, Col: 0, Mark: game.O}, {Row: 1, Col: 1, Mark: game.O}, {Row: 2, Col: 2, Mark: game.O}} {
+		if g, err = g.MakeMoveMark(m.Row, m.Col, m.Mark); err != nil {
+			t.Fatalf("MakeMoveMark() returned error: %v", err)
+		}
+	}
+
+	dir := t.TempDir()
+	for _, name := range []string{"wild.json", "wild.ttn"} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(dir, name)
+			if err := saveFile(path, g); err != nil {
+				t.Fatalf("saveFile() returned error: %v", err)
+			}
+
+			positions, err := replayPositions(path)
+			if err != nil {
+				t.Fatalf("replayPositions() returned error: %v", err)
+			}
+			if final := positions[len(positions)-1]; !final.Board.Equal(g.Board) || final.State != game.Player1Won {
+				t.Errorf("Final position = %q, %v; want %q won by X", final.String(), final.State, g.String())
+			}
+		})
+	}
+}
+
+// TestDescribeMove verifies replayed moves name their square, and their mark in wild games
+func TestDescribeMove(t *testing.T) {
+	move := game.Move{Player: game.Player1, Row: 1, Col: 1, Mark: game.O}
+	tests := []struct {
+		name   string
+		config game.Config
+		want   string
+	}{
+		{"Standard", game.StandardConfig(), "Player 1 (X) plays 1 1 (b2)"},
+		{"Wild", game.Config{Width: 3, Height: 3, WinLength: 3, Variant: game.Wild}, "Player 1 (X) plays O at 1 1 (Ob2)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := describeMove(tt.config, move); got != tt.want {
+				t.Errorf("describeMove() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestHistoryPositionsPartialHistory verifies games saved without early moves start from the oldest recorded position
+func TestHistoryPositionsPartialHistory(t *testing.T) {
+	g := replayTestGame(t)
+	g.History = g.History[2:]
+
+	positions, err := historyPositions(g)
+	if err != nil {
+		t.Fatalf("historyPositions() returned error: %v", err)
+	}
+	if len(positions) != 4 || positions[0].MoveCount != 2 {
+		t.Errorf("historyPositions() = %d positions starting at move %d, want 4 from move 2", len(positions), positions[0].MoveCount)
+	}
+}
+
+// TestReplayerCommands verifies stepping, jumping and autoplay move through the positions
+func TestReplayerCommands(t *testing.T) {
+	positions, err := historyPositions(replayTestGame(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var slept []time.Duration
+	r := &replayer{positions: positions, delay: time.Second, sleep: func(d time.Duration) { slept = append(slept, d) }}
+
+	tests := []struct {
+		input     string
+		wantIndex int
+		wantQuit  bool
+	}{
+		{"", 1, false},
+		{"next", 2, false},
+		{"back", 1, false},
+		{"b", 0, false},
+		{"back", 0, false}, // Already at the start
+		{"jump 4", 4, false},
+		{"j 9", 4, false}, // Out of range
+		{"2", 2, false},
+		{"jump", 2, false},
+		{"dance", 2, false},
+		{"auto", 5, false},
+		{"n", 5, false}, // Already at the end
+		{"quit", 5, true},
+	}
+
+	for _, tt := range tests {
+		quit := r.handle(tt.input)
+		if r.index != tt.wantIndex || quit != tt.wantQuit {
+			t.Errorf("handle(%q) = index %d, quit %v; want %d, %v", tt.input, r.index, quit, tt.wantIndex, tt.wantQuit)
+		}
+	}
+	if len(slept) != 3 {
+		t.Errorf("Autoplay paused %d times, want 3", len(slept))
+	}
+}
+
+// TestReplayerRunStopsAtEndOfInput verifies the prompt loop ends when input runs out
+func TestReplayerRunStopsAtEndOfInput(t *testing.T) {
+	positions, _ := historyPositions(replayTestGame(t))
+	r := &replayer{positions: positions, sleep: func(time.Duration) {}}
+	r.run(bufio.NewScanner(strings.NewReader("next\nnext\n")))
+
+	if r.index != 2 {
+		t.Errorf("index = %d, want 2", r.index)
+	}
+}
+
+// TestRunReplayRequiresFile verifies replay needs exactly one file
+func TestRunReplayRequiresFile(t *testing.T) {
+	if err := runReplay(nil); !errors.Is(err, errReplayFile) {
+		t.Errorf("runReplay() error = %v, want errReplayFile", err)
+	}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+	"github.com/YOUR_USERNAME/tictactoe/game/solver"
+)
+
+// showReview prints a move-by-move review of a finished game and returns true
+// Games without recorded moves and games the solver cannot analyze, such as
+// those on large boards, are skipped
+func showReview(g game.Game) bool {
+	annotations, err := solver.New().Review(g)
+	if err != nil || len(annotations) == 0 {
+		return false
+	}
+	displayReview(annotations)
+	return true
+}
+
+// displayReview prints the annotations as a table followed by a mistake count per player
+// The Best column lists the optimal moves wherever a different move was played
+func displayReview(annotations []solver.Annotation) {
+	fmt.Println("=== Move Review ===")
+	fmt.Printf("%3s  %-6s %-5s %-7s %-7s %-11s %s\n", "#", "Player", "Move", "Before", "After", "Quality", "Best")
+
+	mistakes := map[game.Player][2]int{} // Inaccuracies and blunders
+	for i, a := range annotations {
+		best := ""
+		if a.Quality != solver.Optimal {
+			cells := make([]string, len(a.Best))
+			for j, p := range a.Best {
+				cells[j] = fmt.Sprintf("%d %d", p.Row, p.Col)
+			}
+			best = strings.Join(cells, ", ")
+
+			count := mistakes[a.Player]
+			count[a.Quality-solver.Inaccuracy]++
+			mistakes[a.Player] = count
+		}
+		line := fmt.Sprintf("%3d  %-6s %-5s %-7s %-7s %-11s %s", i+1, a.Player.GetMark(),
+			fmt.Sprintf("%d %d", a.Row, a.Col), a.Before, a.After, a.Quality, best)
+		fmt.Println(strings.TrimRight(line, " "))
+	}
+
+	fmt.Println()
+	for _, p := range []game.Player{game.Player1, game.Player2} {
+		count := mistakes[p]
+		fmt.Printf("%s: %s, %s\n", p.Name(), countNoun(count[0], "inaccuracy", "inaccuracies"), countNoun(count[1], "blunder", "blunders"))
+	}
+}
+
+// countNoun returns n followed by the singular or plural noun, e.g. "2 blunders"
+func countNoun(n int, singular, plural string) string {
+	if n == 1 {
+		return "1 " + singular
+	}
+	return fmt.Sprintf("%d %s", n, plural)
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// TestShowReview verifies reviews are printed for solvable games and skipped otherwise
+func TestShowReview(t *testing.T) {
+	finished := game.NewGame()
+	for _, m := range [][2]int{{1, 1}, {0, 1}, {0, 0}, {2, 2}, {1, 0}, {1, 2}, {2, 0}} {
+		finished, _ = finished.MakeMove(m[0], m[1])
+	}
+	fromPosition, _ := game.ParsePosition("XXX/OO./... o")
+	large, _ := game.NewGameWithConfig(game.Config{Width: 5, Height: 5, WinLength: 3})
+	for _, m := range [][2]int{{0, 0}, {1, 0}, {0, 1}, {1, 1}, {0, 2}} {
+		large, _ = large.MakeMove(m[0], m[1])
+	}
+
+	tests := []struct {
+		name string
+		g    game.Game
+		want bool
+	}{
+		{"Finished", finished, true},
+		{"No history", fromPosition, false},
+		{"Too large", large, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := showReview(tt.g); got != tt.want {
+				t.Errorf("showReview() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestCountNoun verifies singular and plural counts
+func TestCountNoun(t *testing.T) {
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{0, "0 blunders"},
+		{1, "1 blunder"},
+		{2, "2 blunders"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := countNoun(tt.n, "blunder", "blunders"); got != tt.want {
+				t.Errorf("countNoun(%d) = %q, want %q", tt.n, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+	"github.com/YOUR_USERNAME/tictactoe/rating"
+	"github.com/YOUR_USERNAME/tictactoe/stats"
+)
+
+// recorder appends finished games to the stats file under the players' profile names
+// The zero value records nothing
+type recorder struct {
+	path string // Stats file; empty disables recording
+	x    string // Profile playing X
+	o    string // Profile playing O
+}
+
+// newRecorder returns a recorder for the -x and -o names, naming the computer's
+// side after its difficulty
+// Recording is disabled unless both sides have a name
+func newRecorder(path, x, o string, computer opponent, difficulty string, epsilon float64) recorder {
+	if computer.enabled {
+		name := computerName(difficulty, epsilon)
+		if computer.player == game.Player1 {
+			x = name
+		} else {
+			o = name
+		}
+	}
+	if x == "" || o == "" {
+		return recorder{}
+	}
+	return recorder{path: path, x: x, o: o}
+}
+
+// computerName is the profile name of the computer at a difficulty
+// Computers that blunder on purpose get their own profile
+func computerName(difficulty string, epsilon float64) string {
+	if epsilon > 0 {
+		return fmt.Sprintf("Computer (%s, epsilon %g)", difficulty, epsilon)
+	}
+	return fmt.Sprintf("Computer (%s)", difficulty)
+}
+
+// enabled reports whether finished games are recorded
+func (r recorder) enabled() bool {
+	return r.path != ""
+}
+
+// save appends the finished game g to the stats file
+func (r recorder) save(g game.Game) error {
+	if !r.enabled() {
+		return nil
+	}
+	rec, err := stats.NewRecord(r.x, r.o, g, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+	return stats.Append(r.path, rec)
+}
+
+// record saves the finished game g and reports the players' new ratings on the terminal
+func (r recorder) record(g game.Game) {
+	if !r.enabled() {
+		return
+	}
+	if err := r.save(g); err != nil {
+		displayError(err)
+		return
+	}
+	fmt.Printf("Result recorded for %s and %s\n", r.x, r.o)
+
+	records, err := stats.Load(r.path)
+	if err != nil {
+		displayError(err)
+		return
+	}
+	before, after := rating.Compute(records[:len(records)-1]), rating.Compute(records)
+	for _, name := range []string{r.x, r.o} {
+		old, now := before.Get(name).Rating, after.Get(name).Rating
+		fmt.Printf("  %s: rating %.0f (%+.0f)\n", name, now, now-old)
+	}
+}
+
+// statsFileFlag registers -stats-file on fs, defaulting to the user's configuration directory
+func statsFileFlag(fs *flag.FlagSet) *string {
+	path, err := stats.DefaultPath()
+	if err != nil {
+		path = "tictactoe-stats.json"
+	}
+	return fs.String("stats-file", path, "file recording results for player profiles")
+}
+
+// runStats implements `tictactoe stats [name]`: print lifetime statistics
+func runStats(args []string) error {
+	fs := flag.NewFlagSet("stats", flag.ContinueOnError)
+	path := statsFileFlag(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 1 {
+		return errStatsArgs
+	}
+
+	records, err := stats.Load(*path)
+	if err != nil {
+		return err
+	}
+	profiles := stats.Profiles(records)
+	matchups := stats.HeadToHead(records)
+
+	if name := fs.Arg(0); name != "" {
+		profile, ok := stats.Find(profiles, name)
+		if !ok {
+			return fmt.Errorf("no games recorded for %q", name)
+		}
+		profiles = []stats.Profile{profile}
+		matchups = filterMatchups(matchups, name)
+	}
+
+	if len(profiles) == 0 {
+		fmt.Println("No games recorded yet. Name the players with -x and -o to start.")
+		return nil
+	}
+	displayProfiles(profiles)
+	fmt.Println()
+	displayHeadToHead(matchups)
+	return nil
+}
+
+// filterMatchups keeps the matchups from name's side
+func filterMatchups(matchups []stats.Matchup, name string) []stats.Matchup {
+	var kept []stats.Matchup
+	for _, m := range matchups {
+		if m.Player == name {
+			kept = append(kept, m)
+		}
+	}
+	return kept
+}
+
+// displayProfiles prints one row of lifetime statistics per player
+func displayProfiles(profiles []stats.Profile) {
+	width := len("Player")
+	for _, p := range profiles {
+		width = max(width, len(p.Name))
+	}
+
+	fmt.Printf("%-*s %6s %5s %6s %5s %6s %5s %9s\n",
+		width, "Player", "Played", "Wins", "Losses", "Draws", "Streak", "Best", "Avg moves")
+	for _, p := range profiles {
+		fmt.Printf("%-*s %6d %5d %6d %5d %6d %5d %9.1f\n",
+			width, p.Name, p.Played, p.Wins, p.Losses, p.Draws, p.CurrentStreak, p.LongestStreak, p.AverageMovesToWin())
+	}
+}
+
+// displayHeadToHead prints each player's record against each opponent
+func displayHeadToHead(matchups []stats.Matchup) {
+	width := len("Opponent")
+	for _, m := range matchups {
+		width = max(width, len(m.Player), len(m.Opponent))
+	}
+
+	fmt.Println("Head to head")
+	fmt.Printf("%-*s %-*s %5s %5s %5s\n", width, "Player", width, "Opponent", "W", "L", "D")
+	for _, m := range matchups {
+		fmt.Printf("%-*s %-*s %5d %5d %5d\n", width, m.Player, width, m.Opponent, m.Wins, m.Losses, m.Draws)
+	}
+}
+
+// runLeaderboard implements `tictactoe leaderboard`: rank every player by Elo rating
+func runLeaderboard(args []string) error {
+	fs := flag.NewFlagSet("leaderboard", flag.ContinueOnError)
+	path := statsFileFlag(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	records, err := stats.Load(*path)
+	if err != nil {
+		return err
+	}
+	board := rating.Compute(records).Leaderboard()
+	if len(board) == 0 {
+		fmt.Println("No games recorded yet. Name the players with -x and -o to start.")
+		return nil
+	}
+	displayLeaderboard(board)
+	return nil
+}
+
+// displayLeaderboard prints players from highest to lowest rating
+func displayLeaderboard(board []rating.Rating) {
+	width := len("Player")
+	for _, r := range board {
+		width = max(width, len(r.Name))
+	}
+
+	fmt.Printf("%4s  %-*s %6s %5s\n", "Rank", width, "Player", "Rating", "Games")
+	for i, r := range board {
+		fmt.Printf("%4d  %-*s %6.0f %5d\n", i+1, width, r.Name, r.Rating, r.Games)
+	}
+}
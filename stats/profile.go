@@ -0,0 +1,137 @@
+package stats
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Profile is a player's lifetime statistics
+type Profile struct {
+	Name          string
+	Played        int
+	Wins          int
+	Losses        int
+	Draws         int
+	CurrentStreak int // Consecutive wins up to the most recent game
+	LongestStreak int // Most consecutive wins ever
+
+	winningMoves int // Own moves summed over every win
+}
+
+// AverageMovesToWin returns the mean number of marks the player placed in the
+// games they won, or 0 if they have never won
+func (p Profile) AverageMovesToWin() float64 {
+	if p.Wins == 0 {
+		return 0
+	}
+	return float64(p.winningMoves) / float64(p.Wins)
+}
+
+// Matchup is one player's record against a single opponent
+type Matchup struct {
+	Player   string
+	Opponent string
+	Wins     int
+	Losses   int
+	Draws    int
+}
+
+// Played returns the number of games between the two players
+func (m Matchup) Played() int {
+	return m.Wins + m.Losses + m.Draws
+}
+
+// Profiles computes every player's statistics from records, which must be in
+// the order the games were played, and returns them sorted by name
+func Profiles(records []Record) []Profile {
+	byName := make(map[string]*Profile)
+	get := func(name string) *Profile {
+		if byName[name] == nil {
+			byName[name] = &Profile{Name: name}
+		}
+		return byName[name]
+	}
+
+	for _, r := range records {
+		x, o := get(r.X), get(r.O)
+		x.Played++
+		o.Played++
+
+		winnerName, won := r.Winner()
+		if !won {
+			x.Draws++
+			o.Draws++
+			x.CurrentStreak, o.CurrentStreak = 0, 0
+			continue
+		}
+
+		winner, loser := x, o
+		if winnerName == r.O {
+			winner, loser = o, x
+		}
+		winner.Wins++
+		winner.winningMoves += r.WinnerMoves()
+		winner.CurrentStreak++
+		winner.LongestStreak = max(winner.LongestStreak, winner.CurrentStreak)
+		loser.Losses++
+		loser.CurrentStreak = 0
+	}
+
+	profiles := make([]Profile, 0, len(byName))
+	for _, p := range byName {
+		profiles = append(profiles, *p)
+	}
+	slices.SortFunc(profiles, func(a, b Profile) int { return cmp.Compare(a.Name, b.Name) })
+	return profiles
+}
+
+// Find returns the profile of the named player, or false if they have not played
+func Find(profiles []Profile, name string) (Profile, bool) {
+	i := slices.IndexFunc(profiles, func(p Profile) bool { return p.Name == name })
+	if i < 0 {
+		return Profile{}, false
+	}
+	return profiles[i], true
+}
+
+// HeadToHead computes each player's record against each opponent they have
+// faced, sorted by player and then opponent
+// Every pairing appears twice, once from each player's side
+func HeadToHead(records []Record) []Matchup {
+	type pair struct{ player, opponent string }
+	byPair := make(map[pair]*Matchup)
+	get := func(player, opponent string) *Matchup {
+		key := pair{player, opponent}
+		if byPair[key] == nil {
+			byPair[key] = &Matchup{Player: player, Opponent: opponent}
+		}
+		return byPair[key]
+	}
+
+	for _, r := range records {
+		x, o := get(r.X, r.O), get(r.O, r.X)
+		switch winner, won := r.Winner(); {
+		case !won:
+			x.Draws++
+			o.Draws++
+		case winner == r.X:
+			x.Wins++
+			o.Losses++
+		default:
+			o.Wins++
+			x.Losses++
+		}
+	}
+
+	matchups := make([]Matchup, 0, len(byPair))
+	for _, m := range byPair {
+		matchups = append(matchups, *m)
+	}
+	slices.SortFunc(matchups, func(a, b Matchup) int {
+		if c := cmp.Compare(a.Player, b.Player); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Opponent, b.Opponent)
+	})
+	return matchups
+}
@@ -0,0 +1,92 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// testRecords is a short history between three players, in play order
+var testRecords = []Record{
+	{X: "alice", O: "bob", Result: game.Player1Won, Moves: 5},   // alice wins in 3 moves
+	{X: "bob", O: "alice", Result: game.Player2Won, Moves: 6},   // alice wins in 3 moves
+	{X: "alice", O: "carol", Result: game.Player1Won, Moves: 7}, // alice wins in 4 moves
+	{X: "carol", O: "alice", Result: game.Draw, Moves: 9},
+	{X: "bob", O: "carol", Result: game.Player2Won, Moves: 8},
+	{X: "alice", O: "bob", Result: game.Player1Won, Moves: 5},
+}
+
+// TestProfiles verifies lifetime statistics derived from recorded games
+func TestProfiles(t *testing.T) {
+	profiles := Profiles(testRecords)
+
+	tests := []struct {
+		want       Profile
+		wantAvgWin float64
+	}{
+		{Profile{Name: "alice", Played: 5, Wins: 4, Losses: 0, Draws: 1, CurrentStreak: 1, LongestStreak: 3}, 13.0 / 4},
+		{Profile{Name: "bob", Played: 4, Wins: 0, Losses: 4, Draws: 0}, 0},
+		{Profile{Name: "carol", Played: 3, Wins: 1, Losses: 1, Draws: 1, CurrentStreak: 1, LongestStreak: 1}, 4},
+	}
+
+	if len(profiles) != len(tests) {
+		t.Fatalf("Profiles() returned %d profiles, want %d", len(profiles), len(tests))
+	}
+	for i, tt := range tests {
+		t.Run(tt.want.Name, func(t *testing.T) {
+			got := profiles[i]
+			if got.AverageMovesToWin() != tt.wantAvgWin {
+				t.Errorf("AverageMovesToWin() = %v, want %v", got.AverageMovesToWin(), tt.wantAvgWin)
+			}
+			got.winningMoves = 0
+			if got != tt.want {
+				t.Errorf("Profile = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestProfilesMisere verifies a misere winner's average counts only their own marks
+func TestProfilesMisere(t *testing.T) {
+	records := []Record{{X: "alice", O: "bob", Result: game.Player2Won, Moves: 5, Variant: game.Misere}}
+	bob, ok := Find(Profiles(records), "bob")
+	if !ok || bob.AverageMovesToWin() != 2 {
+		t.Errorf("AverageMovesToWin() = %v, want 2 after winning with 2 marks", bob.AverageMovesToWin())
+	}
+}
+
+// TestFind verifies profiles are looked up by exact name
+func TestFind(t *testing.T) {
+	profiles := Profiles(testRecords)
+	if p, ok := Find(profiles, "carol"); !ok || p.Played != 3 {
+		t.Errorf("Find(carol) = %+v, %v", p, ok)
+	}
+	if _, ok := Find(profiles, "dave"); ok {
+		t.Error("Find(dave) found a profile for a player with no games")
+	}
+}
+
+// TestHeadToHead verifies each pairing is tallied from both players' sides
+func TestHeadToHead(t *testing.T) {
+	want := []Matchup{
+		{Player: "alice", Opponent: "bob", Wins: 3},
+		{Player: "alice", Opponent: "carol", Wins: 1, Draws: 1},
+		{Player: "bob", Opponent: "alice", Losses: 3},
+		{Player: "bob", Opponent: "carol", Losses: 1},
+		{Player: "carol", Opponent: "alice", Losses: 1, Draws: 1},
+		{Player: "carol", Opponent: "bob", Wins: 1},
+	}
+
+	got := HeadToHead(testRecords)
+	if len(got) != len(want) {
+		t.Fatalf("HeadToHead() returned %d matchups, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Matchup %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+	if got[1].Played() != 2 {
+		t.Errorf("Played() = %d, want 2", got[1].Played())
+	}
+}
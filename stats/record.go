@@ -0,0 +1,144 @@
+// Package stats records finished games between named players and derives
+// lifetime statistics from them
+//
+// Results are kept as an append-only log in a JSON file; profiles and
+// head-to-head tables are recomputed from the log whenever they are needed.
+package stats
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// FileVersion is the schema version written by Save
+const FileVersion = 1
+
+// Record errors
+var (
+	// ErrInvalidName indicates a player name is empty or both sides share one
+	ErrInvalidName = errors.New("player names must be non-empty and different")
+
+	// ErrUnfinishedGame indicates a game was recorded before it ended
+	ErrUnfinishedGame = errors.New("only finished games can be recorded")
+
+	// ErrUnsupportedVersion indicates a stats file written by an unknown schema version
+	ErrUnsupportedVersion = errors.New("unsupported stats file version")
+)
+
+// Record is the result of one finished game
+type Record struct {
+	X        string         `json:"x"`                  // Profile that played X
+	O        string         `json:"o"`                  // Profile that played O
+	Result   game.GameState `json:"result"`             // How the game ended
+	Moves    int            `json:"moves"`              // Marks placed by both players
+	Variant  game.Variant   `json:"variant,omitempty"`  // Rules the game was played under; omitted for standard
+	PlayedAt time.Time      `json:"played_at,omitzero"` // When the game finished
+}
+
+// NewRecord returns the record of the finished game g between x and o
+func NewRecord(x, o string, g game.Game, playedAt time.Time) (Record, error) {
+	x, o = strings.TrimSpace(x), strings.TrimSpace(o)
+	if x == "" || o == "" || x == o {
+		return Record{}, ErrInvalidName
+	}
+	if g.State == game.InProgress {
+		return Record{}, ErrUnfinishedGame
+	}
+	return Record{X: x, O: o, Result: g.State, Moves: g.MoveCount, Variant: g.Config.Variant, PlayedAt: playedAt}, nil
+}
+
+// WinnerMoves returns the number of marks the winner placed, or 0 for a draw
+// The player who completed the line placed the last mark and so made the
+// larger half of the moves; under misere rules that player lost
+func (r Record) WinnerMoves() int {
+	if _, won := r.Winner(); !won {
+		return 0
+	}
+	if r.Variant == game.Misere {
+		return r.Moves / 2
+	}
+	return (r.Moves + 1) / 2
+}
+
+// Winner returns the name of the winning profile, or false for a draw
+func (r Record) Winner() (string, bool) {
+	switch r.Result {
+	case game.Player1Won:
+		return r.X, true
+	case game.Player2Won:
+		return r.O, true
+	default:
+		return "", false
+	}
+}
+
+// file is the JSON schema of a stats file
+type file struct {
+	Version int      `json:"version"`
+	Games   []Record `json:"games"`
+}
+
+// DefaultPath returns the stats file in the user's configuration directory
+func DefaultPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "tictactoe", "stats.json"), nil
+}
+
+// Load reads every record from the stats file at path
+// A missing file holds no records
+func Load(path string) ([]Record, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var f file
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if f.Version != FileVersion {
+		return nil, fmt.Errorf("%s: %w %d", path, ErrUnsupportedVersion, f.Version)
+	}
+	return f.Games, nil
+}
+
+// Save replaces the stats file at path with records, creating its directory if needed
+// The file is written to a temporary name first so a failed write keeps the old file
+func Save(path string, records []Record) error {
+	data, err := json.MarshalIndent(file{Version: FileVersion, Games: records}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Append adds rec to the stats file at path
+func Append(path string, rec Record) error {
+	records, err := Load(path)
+	if err != nil {
+		return err
+	}
+	return Save(path, append(records, rec))
+}
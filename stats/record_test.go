@@ -0,0 +1,165 @@
+package stats
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+)
+
+// finished returns a finished standard game in state after moves marks
+func finished(state game.GameState, moves int) game.Game {
+	g := game.NewGame()
+	g.State = state
+	g.MoveCount = moves
+	return g
+}
+
+// misereFinished returns a misere game that ended in state after moves marks
+func misereFinished(state game.GameState, moves int) game.Game {
+	g := finished(state, moves)
+	g.Config.Variant = game.Misere
+	return g
+}
+
+// TestNewRecord verifies records require two distinct names and a finished game
+func TestNewRecord(t *testing.T) {
+	tests := []struct {
+		name    string
+		x, o    string
+		g       game.Game
+		wantErr error
+	}{
+		{"X wins", "alice", "bob", finished(game.Player1Won, 5), nil},
+		{"Names are trimmed", " alice ", "bob", finished(game.Draw, 9), nil},
+		{"Empty name", "", "bob", finished(game.Draw, 9), ErrInvalidName},
+		{"Same player", "alice", "alice", finished(game.Draw, 9), ErrInvalidName},
+		{"Unfinished", "alice", "bob", game.NewGame(), ErrUnfinishedGame},
+		{"Misere keeps its variant", "alice", "bob", misereFinished(game.Player2Won, 5), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec, err := NewRecord(tt.x, tt.o, tt.g, time.Time{})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewRecord() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && (rec.X != "alice" || rec.O != "bob" || rec.Result != tt.g.State || rec.Moves != tt.g.MoveCount ||
+				rec.Variant != tt.g.Config.Variant) {
+				t.Errorf("NewRecord() = %+v", rec)
+			}
+		})
+	}
+}
+
+// TestRecordWinner verifies the winner is derived from the game state
+func TestRecordWinner(t *testing.T) {
+	tests := []struct {
+		result     game.GameState
+		wantWinner string
+		wantWon    bool
+	}{
+		{game.Player1Won, "alice", true},
+		{game.Player2Won, "bob", true},
+		{game.Draw, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.result.String(), func(t *testing.T) {
+			winner, won := Record{X: "alice", O: "bob", Result: tt.result}.Winner()
+			if winner != tt.wantWinner || won != tt.wantWon {
+				t.Errorf("Winner() = %q, %v; want %q, %v", winner, won, tt.wantWinner, tt.wantWon)
+			}
+		})
+	}
+}
+
+// TestRecordWinnerMoves verifies the winner is credited with the marks they
+// placed, which under misere rules excludes the losing last mark
+func TestRecordWinnerMoves(t *testing.T) {
+	tests := []struct {
+		name   string
+		record Record
+		want   int
+	}{
+		{"X completes a line", Record{Result: game.Player1Won, Moves: 5}, 3},
+		{"O completes a line", Record{Result: game.Player2Won, Moves: 6}, 3},
+		{"Misere win for O after X's fifth move", Record{Result: game.Player2Won, Moves: 5, Variant: game.Misere}, 2},
+		{"Misere win for X after O's third move", Record{Result: game.Player1Won, Moves: 6, Variant: game.Misere}, 3},
+		{"Wild win goes to the last mover", Record{Result: game.Player1Won, Moves: 5, Variant: game.Wild}, 3},
+		{"Draw", Record{Result: game.Draw, Moves: 9}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.record.WinnerMoves(); got != tt.want {
+				t.Errorf("WinnerMoves() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestAppendLoad verifies records survive the stats file and a missing file is empty
+func TestAppendLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "stats.json")
+
+	records, err := Load(path)
+	if err != nil || len(records) != 0 {
+		t.Fatalf("Load(missing) = %v, %v; want no records", records, err)
+	}
+
+	played := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	want := []Record{
+		{X: "alice", O: "bob", Result: game.Player1Won, Moves: 5, PlayedAt: played},
+		{X: "bob", O: "alice", Result: game.Draw, Moves: 9},
+	}
+	for _, rec := range want {
+		if err := Append(path, rec); err != nil {
+			t.Fatalf("Append() returned error: %v", err)
+		}
+	}
+
+	got, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() returned error: %v", err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Load() returned %d records, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if !got[i].PlayedAt.Equal(want[i].PlayedAt) {
+			t.Errorf("Record %d PlayedAt = %v, want %v", i, got[i].PlayedAt, want[i].PlayedAt)
+		}
+		got[i].PlayedAt, want[i].PlayedAt = time.Time{}, time.Time{}
+		if got[i] != want[i] {
+			t.Errorf("Record %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+// TestLoadRejectsBadFiles verifies corrupt files and unknown versions are reported
+func TestLoadRejectsBadFiles(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr error
+	}{
+		{"Unknown version", `{"version":9,"games":[]}`, ErrUnsupportedVersion},
+		{"Unknown result", `{"version":1,"games":[{"x":"a","o":"b","result":"won"}]}`, game.ErrCorruptSave},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "stats.json")
+			if err := os.WriteFile(path, []byte(tt.data), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := Load(path); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Load() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+	"github.com/YOUR_USERNAME/tictactoe/rating"
+	"github.com/YOUR_USERNAME/tictactoe/stats"
+)
+
+// TestNewRecorder verifies profile names for each side, including the computer's
+func TestNewRecorder(t *testing.T) {
+	computerO := opponent{enabled: true, player: game.Player2}
+
+	tests := []struct {
+		name        string
+		x, o        string
+		computer    opponent
+		epsilon     float64
+		wantEnabled bool
+		wantX       string
+		wantO       string
+	}{
+		{"Two named humans", "alice", "bob", opponent{}, 0, true, "alice", "bob"},
+		{"Unnamed side", "alice", "", opponent{}, 0, false, "", ""},
+		{"Against the computer", "alice", "", computerO, 0, true, "alice", "Computer (perfect)"},
+		{"Computer with epsilon", "alice", "", computerO, 0.25, true, "alice", "Computer (perfect, epsilon 0.25)"},
+		{"Computer overrides name", "alice", "bob", computerO, 0, true, "alice", "Computer (perfect)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newRecorder("stats.json", tt.x, tt.o, tt.computer, "perfect", tt.epsilon)
+			if r.enabled() != tt.wantEnabled {
+				t.Fatalf("enabled() = %v, want %v", r.enabled(), tt.wantEnabled)
+			}
+			if tt.wantEnabled && (r.x != tt.wantX || r.o != tt.wantO) {
+				t.Errorf("Names = %q, %q; want %q, %q", r.x, r.o, tt.wantX, tt.wantO)
+			}
+		})
+	}
+}
+
+// TestRecorderSave verifies finished games reach the stats file and unfinished ones do not
+func TestRecorderSave(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stats.json")
+	r := newRecorder(path, "alice", "bob", opponent{}, "perfect", 0)
+
+	g := game.NewGame()
+	for _, m := range [][2]int{{0, 0}, {1, 0}, {0, 1}, {1, 1}, {0, 2}} {
+		g, _ = g.MakeMove(m[0], m[1])
+	}
+	if err := r.save(g); err != nil {
+		t.Fatalf("save() returned error: %v", err)
+	}
+	if err := r.save(game.NewGame()); !errors.Is(err, stats.ErrUnfinishedGame) {
+		t.Errorf("save(unfinished) error = %v, want ErrUnfinishedGame", err)
+	}
+
+	records, err := stats.Load(path)
+	if err != nil {
+		t.Fatalf("Load() returned error: %v", err)
+	}
+	if len(records) != 1 || records[0].X != "alice" || records[0].Result != game.Player1Won {
+		t.Errorf("Records = %+v, want one alice win", records)
+	}
+
+	if err := (recorder{}).save(g); err != nil {
+		t.Errorf("Disabled recorder save() returned error: %v", err)
+	}
+}
+
+// TestPlayPromptRecordsOnlyPlayedGames verifies a save that was already finished
+// is not recorded again, whether opened at startup or loaded at the prompt
+func TestPlayPromptRecordsOnlyPlayedGames(t *testing.T) {
+	won := game.NewGame()
+	for _, m := range [][2]int{{0, 0}, {1, 0}, {0, 1}, {1, 1}, {0, 2}} {
+		won, _ = won.MakeMove(m[0], m[1])
+	}
+	save := filepath.Join(t.TempDir(), "won.json")
+	if err := saveFile(save, won); err != nil {
+		t.Fatalf("saveFile() returned error: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		start       game.Game
+		input       string
+		wantRecords int
+	}{
+		{"Finished save opened", won, "", 0},
+		{"Finished save loaded at the prompt", game.NewGame(), "0 0\nload " + save + "\n", 0},
+		{"Game played to a win", game.NewGame(), "0 0\n1 0\n0 1\n1 1\n0 2\n", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "stats.json")
+			r := newRecorder(path, "alice", "bob", opponent{}, "perfect", 0)
+			playPrompt(tt.start, bufio.NewScanner(strings.NewReader(tt.input)), opponent{}, r)
+
+			records, err := stats.Load(path)
+			if err != nil {
+				t.Fatalf("Load() returned error: %v", err)
+			}
+			if len(records) != tt.wantRecords {
+				t.Errorf("Recorded %d games, want %d", len(records), tt.wantRecords)
+			}
+		})
+	}
+}
+
+// TestReopenedWinKeepsRatings verifies reopening a finished save cannot be used
+// to raise a player's rating
+func TestReopenedWinKeepsRatings(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stats.json")
+	r := newRecorder(path, "alice", "bob", opponent{}, "perfect", 0)
+	topRowWin := "0 0\n1 0\n0 1\n1 1\n0 2\n"
+	playPrompt(game.NewGame(), bufio.NewScanner(strings.NewReader(topRowWin)), opponent{}, r)
+
+	records, _ := stats.Load(path)
+	want := rating.Compute(records).Get("alice").Rating
+	won, _ := game.NewGame().MakeMove(0, 0)
+	for _, m := range [][2]int{{1, 0}, {0, 1}, {1, 1}, {0, 2}} {
+		won, _ = won.MakeMove(m[0], m[1])
+	}
+	for range 3 {
+		playPrompt(won, bufio.NewScanner(strings.NewReader("")), opponent{}, r)
+	}
+
+	records, err := stats.Load(path)
+	if err != nil {
+		t.Fatalf("Load() returned error: %v", err)
+	}
+	if got := rating.Compute(records).Get("alice").Rating; len(records) != 1 || got != want {
+		t.Errorf("alice rating = %.1f over %d records, want %.1f over 1", got, len(records), want)
+	}
+}
+
+// TestRunStats verifies the stats subcommand's arguments
+func TestRunStats(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stats.json")
+	if err := stats.Append(path, stats.Record{X: "alice", O: "bob", Result: game.Draw, Moves: 9}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+	}{
+		{"All players", []string{"-stats-file", path}, false},
+		{"One player", []string{"-stats-file", path, "bob"}, false},
+		{"Unknown player", []string{"-stats-file", path, "carol"}, true},
+		{"Too many names", []string{"-stats-file", path, "alice", "bob"}, true},
+		{"No file yet", []string{"-stats-file", filepath.Join(t.TempDir(), "none.json")}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := runStats(tt.args); (err != nil) != tt.wantErr {
+				t.Errorf("runStats(%v) error = %v, wantErr %v", tt.args, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestRunLeaderboard verifies the leaderboard subcommand reads the stats file
+func TestRunLeaderboard(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "stats.json")
+	if err := stats.Append(path, stats.Record{X: "alice", O: "Computer (perfect)", Result: game.Draw, Moves: 9}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr bool
+	}{
+		{"Recorded games", []string{"-stats-file", path}, false},
+		{"No file yet", []string{"-stats-file", filepath.Join(dir, "none.json")}, false},
+		{"Unknown flag", []string{"-top", "3"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := runLeaderboard(tt.args); (err != nil) != tt.wantErr {
+				t.Errorf("runLeaderboard(%v) error = %v, wantErr %v", tt.args, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,259 @@
+// Package tui provides a full-screen terminal interface for tic-tac-toe
+//
+// The board is a grid navigated with the arrow keys, where Enter or a mouse
+// click places a mark. A status bar shows whose turn it is, errors appear in
+// a modal dialog, and a finished game offers a rematch.
+package tui
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+	"github.com/YOUR_USERNAME/tictactoe/game/ai"
+)
+
+// Page names
+const (
+	pageBoard    = "board"
+	pageError    = "error"
+	pageGameOver = "game-over"
+)
+
+// Button labels of the game-over dialog
+const (
+	buttonRematch = "Rematch"
+	buttonQuit    = "Quit"
+)
+
+// Computer describes the computer player in single-player mode
+type Computer struct {
+	Player   game.Player // Side the computer plays
+	Strategy ai.Strategy // How the computer chooses its moves
+}
+
+// UI is the full-screen interface for a single game and its rematches
+type UI struct {
+	app      *tview.Application
+	pages    *tview.Pages
+	board    *tview.Table
+	status   *tview.TextView
+	computer *Computer // nil when two humans play
+	gameOver func(game.Game)
+
+	game game.Game
+}
+
+// New builds the interface for g; computer may be nil for a two-player game
+func New(g game.Game, computer *Computer) *UI {
+	ui := &UI{
+		app:      tview.NewApplication(),
+		pages:    tview.NewPages(),
+		board:    tview.NewTable(),
+		status:   tview.NewTextView().SetDynamicColors(true),
+		computer: computer,
+	}
+
+	ui.board.SetBorders(true).SetSelectable(true, true)
+	ui.board.SetSelectedFunc(ui.place)
+	ui.board.SetInputCapture(ui.handleKey)
+
+	title := tview.NewTextView().SetText("=== Tic-Tac-Toe ===").SetTextAlign(tview.AlignCenter)
+	layout := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(title, 1, 0, false).
+		AddItem(centered(ui.board, 4*g.Board.Width()+1, 2*g.Board.Height()+1), 0, 1, true).
+		AddItem(ui.status, 1, 0, false)
+
+	ui.pages.AddPage(pageBoard, layout, true, true)
+	ui.app.SetRoot(ui.pages, true).EnableMouse(true)
+
+	ui.start(g)
+	return ui
+}
+
+// Run shows the interface until the player quits
+func (ui *UI) Run() error {
+	return ui.app.Run()
+}
+
+// SetGameOverFunc sets a function called with each game as it finishes,
+// before the rematch dialog is shown
+func (ui *UI) SetGameOverFunc(handler func(game.Game)) *UI {
+	ui.gameOver = handler
+	return ui
+}
+
+// Game returns the game currently on screen
+func (ui *UI) Game() game.Game {
+	return ui.game
+}
+
+// start shows g, letting the computer open if it moves first
+func (ui *UI) start(g game.Game) {
+	ui.game = g
+	ui.playComputer()
+	ui.render()
+	ui.board.Select(g.Board.Height()/2, g.Board.Width()/2)
+	ui.finishIfOver()
+}
+
+// place plays the current player's mark at row, col and lets the computer reply
+func (ui *UI) place(row, col int) {
+	if ui.game.State != game.InProgress || ui.computerToMove() {
+		return
+	}
+
+	next, err := ui.game.MakeMove(row, col)
+	if err != nil {
+		ui.showError(err)
+		return
+	}
+	ui.game = next
+	ui.playComputer()
+	ui.render()
+	ui.finishIfOver()
+}
+
+// playComputer makes the computer's move if it is the computer's turn
+func (ui *UI) playComputer() {
+	if !ui.computerToMove() {
+		return
+	}
+	row, col, err := ui.computer.Strategy.ChooseMove(ui.game.Board, ui.game.CurrentPlayer)
+	if err == nil {
+		ui.game, err = ui.game.MakeMove(row, col)
+	}
+	if err != nil {
+		ui.showError(err)
+	}
+}
+
+// computerToMove reports whether the computer plays the side to move
+func (ui *UI) computerToMove() bool {
+	return ui.computer != nil && ui.game.State == game.InProgress && ui.computer.Player == ui.game.CurrentPlayer
+}
+
+// handleKey quits on q or Escape and passes every other key on to the board
+func (ui *UI) handleKey(event *tcell.EventKey) *tcell.EventKey {
+	if event.Key() == tcell.KeyEscape || event.Rune() == 'q' {
+		ui.app.Stop()
+		return nil
+	}
+	return event
+}
+
+// render redraws the board cells and the status bar from the game
+func (ui *UI) render() {
+	board := ui.game.Board
+	for row := 0; row < board.Height(); row++ {
+		for col := 0; col < board.Width(); col++ {
+			r, c := row, col
+			cell := tview.NewTableCell(" " + board.GetCell(row, col).String() + " ").
+				SetAlign(tview.AlignCenter).
+				SetTextColor(cellColor(board.GetCell(row, col))).
+				SetClickedFunc(func() bool {
+					ui.place(r, c)
+					return false
+				})
+			ui.board.SetCell(row, col, cell)
+		}
+	}
+	ui.status.SetText(statusText(ui.game, ui.computer))
+}
+
+// finishIfOver shows the game-over dialog once the game has finished
+func (ui *UI) finishIfOver() {
+	if ui.game.State == game.InProgress {
+		return
+	}
+	if ui.gameOver != nil {
+		ui.gameOver(ui.game)
+	}
+	modal := tview.NewModal().
+		SetText(resultText(ui.game.State) + "\n\nPlay again?").
+		AddButtons([]string{buttonRematch, buttonQuit}).
+		SetDoneFunc(func(_ int, label string) {
+			ui.pages.RemovePage(pageGameOver)
+			if label == buttonRematch {
+				ui.rematch()
+				return
+			}
+			ui.app.Stop()
+		})
+	ui.pages.AddPage(pageGameOver, modal, true, true)
+	ui.app.SetFocus(modal)
+}
+
+// rematch starts a new game on the same board with the same players
+func (ui *UI) rematch() {
+	g, err := game.NewGameWithConfig(ui.game.Config)
+	if err != nil {
+		ui.showError(err)
+		return
+	}
+	ui.app.SetFocus(ui.board)
+	ui.start(g)
+}
+
+// showError displays err in a modal dialog until it is dismissed
+func (ui *UI) showError(err error) {
+	modal := tview.NewModal().
+		SetText(err.Error()).
+		AddButtons([]string{"OK"}).
+		SetDoneFunc(func(int, string) {
+			ui.pages.RemovePage(pageError)
+			ui.app.SetFocus(ui.board)
+		})
+	ui.pages.AddPage(pageError, modal, true, true)
+	ui.app.SetFocus(modal)
+}
+
+// statusText describes whose turn it is and the state of g
+func statusText(g game.Game, computer *Computer) string {
+	if g.State != game.InProgress {
+		return fmt.Sprintf(" %s | Rematch or quit", g.State)
+	}
+	player := g.CurrentPlayer.Name()
+	if computer != nil && computer.Player == g.CurrentPlayer {
+		player += " (computer)"
+	}
+	return fmt.Sprintf(" %s's turn | %s | arrows move, Enter or click places, q quits", player, g.State)
+}
+
+// resultText announces the outcome of a finished game
+func resultText(state game.GameState) string {
+	switch state {
+	case game.Player1Won:
+		return "Player 1 (X) wins!"
+	case game.Player2Won:
+		return "Player 2 (O) wins!"
+	default:
+		return "It's a draw!"
+	}
+}
+
+// cellColor returns the text color of a mark
+func cellColor(c game.Cell) tcell.Color {
+	switch c {
+	case game.X:
+		return tcell.ColorRed
+	case game.O:
+		return tcell.ColorDodgerBlue
+	default:
+		return tcell.ColorDefault
+	}
+}
+
+// centered places p in the middle of the screen at the given size
+func centered(p tview.Primitive, width, height int) tview.Primitive {
+	row := tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(p, width, 0, true).
+		AddItem(nil, 0, 1, false)
+	return tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(nil, 0, 1, false).
+		AddItem(row, height, 0, true).
+		AddItem(nil, 0, 1, false)
+}
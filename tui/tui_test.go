@@ -0,0 +1,141 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+	"github.com/YOUR_USERNAME/tictactoe/game/ai"
+)
+
+// frontPage returns the name of the page currently on top
+func frontPage(ui *UI) string {
+	name, _ := ui.pages.GetFrontPage()
+	return name
+}
+
+// TestPlaceUpdatesBoardAndStatus verifies placing a mark redraws the cell and status bar
+func TestPlaceUpdatesBoardAndStatus(t *testing.T) {
+	ui := New(game.NewGame(), nil)
+	ui.place(1, 1)
+
+	if got := ui.Game().Board.GetCell(1, 1); got != game.X {
+		t.Errorf("Board cell (1,1) = %v, want X", got)
+	}
+	if got := ui.board.GetCell(1, 1).Text; !strings.Contains(got, "X") {
+		t.Errorf("Grid cell (1,1) text = %q, want X", got)
+	}
+	if got := ui.status.GetText(false); !strings.Contains(got, "Player 2 (O)'s turn") {
+		t.Errorf("Status = %q, want Player 2 (O)'s turn", got)
+	}
+}
+
+// TestOccupiedCellShowsErrorModal verifies invalid moves open the error dialog
+func TestOccupiedCellShowsErrorModal(t *testing.T) {
+	ui := New(game.NewGame(), nil)
+	ui.place(0, 0)
+	ui.place(0, 0)
+
+	if got := frontPage(ui); got != pageError {
+		t.Errorf("Front page = %q, want %q", got, pageError)
+	}
+	if ui.Game().MoveCount != 1 {
+		t.Errorf("MoveCount = %d, want 1", ui.Game().MoveCount)
+	}
+}
+
+// TestComputerMoves verifies the computer opens when it plays X and replies when it plays O
+func TestComputerMoves(t *testing.T) {
+	tests := []struct {
+		name          string
+		player        game.Player
+		humanMoves    int
+		wantMoveCount int
+	}{
+		{"Computer opens as X", game.Player1, 0, 1},
+		{"Computer replies as O", game.Player2, 1, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ui := New(game.NewGame(), &Computer{Player: tt.player, Strategy: ai.NewPerfect(game.StandardConfig())})
+			if tt.humanMoves > 0 {
+				ui.place(1, 1)
+			}
+			if got := ui.Game().MoveCount; got != tt.wantMoveCount {
+				t.Errorf("MoveCount = %d, want %d", got, tt.wantMoveCount)
+			}
+			if ui.Game().CurrentPlayer == tt.player {
+				t.Error("Computer is still to move")
+			}
+		})
+	}
+}
+
+// TestGameOverOffersRematch verifies a finished game opens the dialog and a rematch restarts it
+func TestGameOverOffersRematch(t *testing.T) {
+	var finished []game.GameState
+	ui := New(game.NewGame(), nil).SetGameOverFunc(func(g game.Game) {
+		finished = append(finished, g.State)
+	})
+	for _, m := range [][2]int{{0, 0}, {1, 0}, {0, 1}, {1, 1}, {0, 2}} {
+		ui.place(m[0], m[1])
+	}
+
+	if len(finished) != 1 || finished[0] != game.Player1Won {
+		t.Errorf("Game-over handler saw %v, want [Player1Won]", finished)
+	}
+
+	if got := frontPage(ui); got != pageGameOver {
+		t.Fatalf("Front page = %q, want %q", got, pageGameOver)
+	}
+	if got := ui.status.GetText(false); !strings.Contains(got, "Player 1 (X) won") {
+		t.Errorf("Status = %q, want Player 1 (X) won", got)
+	}
+
+	ui.place(2, 2)
+	if ui.Game().MoveCount != 5 {
+		t.Errorf("Move after game over was played")
+	}
+
+	ui.pages.RemovePage(pageGameOver)
+	ui.rematch()
+	if ui.Game().MoveCount != 0 || ui.Game().State != game.InProgress {
+		t.Errorf("Rematch game = %+v, want a fresh game", ui.Game())
+	}
+}
+
+// TestKeyboardNavigation drives the running interface with arrow keys and Enter
+func TestKeyboardNavigation(t *testing.T) {
+	screen := tcell.NewSimulationScreen("UTF-8")
+	ui := New(game.NewGame(), nil)
+	ui.app.SetScreen(screen)
+
+	done := make(chan error, 1)
+	go func() { done <- ui.Run() }()
+
+	// The cursor starts in the center; place there, then move to the top-left corner
+	keys := []tcell.Key{tcell.KeyEnter, tcell.KeyUp, tcell.KeyLeft, tcell.KeyEnter}
+	for _, key := range keys {
+		screen.InjectKey(key, 0, tcell.ModNone)
+	}
+	screen.InjectKey(tcell.KeyRune, 'q', tcell.ModNone)
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Run() returned error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		ui.app.Stop()
+		t.Fatal("Run() did not stop after q")
+	}
+
+	board := ui.Game().Board
+	if board.GetCell(1, 1) != game.X || board.GetCell(0, 0) != game.O {
+		t.Errorf("Board = %v, want X at 1 1 and O at 0 0", board)
+	}
+}
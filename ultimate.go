@@ -0,0 +1,142 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+	"github.com/YOUR_USERNAME/tictactoe/game/ultimate"
+)
+
+// errUltimateArgs indicates ultimate was given positional arguments
+var errUltimateArgs = errors.New("usage: tictactoe ultimate")
+
+// runUltimate implements `tictactoe ultimate`: a two-player game of Ultimate Tic-Tac-Toe
+func runUltimate(args []string) error {
+	fs := flag.NewFlagSet("ultimate", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		return errUltimateArgs
+	}
+
+	fmt.Println("=== Ultimate Tic-Tac-Toe ===")
+	g := runUltimateGame(ultimate.NewGame(), bufio.NewScanner(os.Stdin))
+
+	fmt.Println()
+	fmt.Print(renderUltimate(g))
+	fmt.Println()
+	displayResult(g.State)
+	return nil
+}
+
+// runUltimateGame reads moves from scanner until the game ends or input runs out
+func runUltimateGame(g ultimate.Game, scanner *bufio.Scanner) ultimate.Game {
+	for g.State == game.InProgress {
+		fmt.Println()
+		fmt.Print(renderUltimate(g))
+		fmt.Println()
+		fmt.Print(ultimatePrompt(g))
+
+		if !scanner.Scan() {
+			break
+		}
+		board, cell, err := g.ParseMove(scanner.Text())
+		if err != nil {
+			displayError(err)
+			continue
+		}
+		next, err := g.MakeMove(board, cell)
+		if err != nil {
+			displayError(err)
+			continue
+		}
+		g = next
+	}
+	return g
+}
+
+// ultimatePrompt tells the current player where they may play and how to enter a move
+func ultimatePrompt(g ultimate.Game) string {
+	if g.AnyBoard {
+		return fmt.Sprintf("%s, play in any open board.\nEnter board row, board column, row and column, e.g., '1 1 0 2': ",
+			g.CurrentPlayer.Name())
+	}
+	return fmt.Sprintf("%s, play in board %d %d.\nEnter row and column (0-2), e.g., '1 1': ",
+		g.CurrentPlayer.Name(), g.Next.Row, g.Next.Col)
+}
+
+// renderUltimate draws the nine sub-boards as one 9x9 grid beside the meta board
+// Empty cells are shown as dots in the boards the current player may play
+// in and left blank elsewhere. The outer labels number the sub-boards and
+// the inner labels number the cells within each sub-board
+func renderUltimate(g ultimate.Game) string {
+	var s strings.Builder
+	s.WriteString("         0       1       2        Meta\n")
+	s.WriteString("       0 1 2   0 1 2   0 1 2\n")
+
+	for boardRow := range ultimate.Size {
+		if boardRow > 0 {
+			s.WriteString("      -------+-------+-------\n")
+		}
+		for row := range ultimate.Size {
+			var line strings.Builder
+			label := "   "
+			if row == 0 {
+				label = fmt.Sprintf("%2d ", boardRow)
+			}
+			fmt.Fprintf(&line, "%s %d ", label, row)
+
+			for boardCol := range ultimate.Size {
+				if boardCol > 0 {
+					line.WriteString(" |")
+				}
+				board := game.Position{Row: boardRow, Col: boardCol}
+				for col := range ultimate.Size {
+					fmt.Fprintf(&line, " %s", ultimateCell(g, board, row, col))
+				}
+			}
+
+			// The meta board is drawn beside the middle row of each band of sub-boards
+			if row == 1 {
+				line.WriteString("     ")
+				for boardCol := range ultimate.Size {
+					fmt.Fprintf(&line, " %s", metaCell(g, game.Position{Row: boardRow, Col: boardCol}))
+				}
+			}
+			s.WriteString(strings.TrimRight(line.String(), " ") + "\n")
+		}
+	}
+	return s.String()
+}
+
+// ultimateCell returns the character drawn for a cell of a sub-board
+func ultimateCell(g ultimate.Game, board game.Position, row, col int) string {
+	cell := g.Boards[board.Row][board.Col].GetCell(row, col)
+	switch {
+	case cell.IsOccupied():
+		return cell.String()
+	case g.CanPlayIn(board):
+		return "."
+	default:
+		return " "
+	}
+}
+
+// metaCell returns the character drawn for a sub-board on the meta board:
+// its winner, "-" for a draw or "." while it is open
+func metaCell(g ultimate.Game, board game.Position) string {
+	switch g.States[board.Row][board.Col] {
+	case game.Player1Won, game.Player2Won:
+		return g.Meta.GetCell(board.Row, board.Col).String()
+	case game.Draw:
+		return "-"
+	default:
+		return "."
+	}
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/YOUR_USERNAME/tictactoe/game"
+	"github.com/YOUR_USERNAME/tictactoe/game/ultimate"
+)
+
+// TestRunUltimateArgs verifies the ultimate subcommand takes no positional arguments
+func TestRunUltimateArgs(t *testing.T) {
+	if err := runUltimate([]string{"extra"}); !errors.Is(err, errUltimateArgs) {
+		t.Errorf("runUltimate([extra]) error = %v, want errUltimateArgs", err)
+	}
+}
+
+// TestRunUltimateGame verifies scripted moves are played and bad input is skipped
+func TestRunUltimateGame(t *testing.T) {
+	input := strings.Join([]string{
+		"1 1",     // X must choose a board on the first move
+		"1 1 0 2", // X plays in the centre board, sending O to board 0 2
+		"2 2 0 0", // Wrong board
+		"5 5",     // Out of range
+		"2 1",     // O plays in board 0 2, sending X to board 2 1
+		"2 1 2 1", // X names the board it was sent to explicitly
+	}, "\n")
+	g := runUltimateGame(ultimate.NewGame(), bufio.NewScanner(strings.NewReader(input)))
+
+	want := []ultimate.Move{
+		{Player: game.Player1, Board: game.Position{Row: 1, Col: 1}, Cell: game.Position{Row: 0, Col: 2}},
+		{Player: game.Player2, Board: game.Position{Row: 0, Col: 2}, Cell: game.Position{Row: 2, Col: 1}},
+		{Player: game.Player1, Board: game.Position{Row: 2, Col: 1}, Cell: game.Position{Row: 2, Col: 1}},
+	}
+	if len(g.History) != len(want) {
+		t.Fatalf("History has %d moves, want %d: %+v", len(g.History), len(want), g.History)
+	}
+	for i, m := range want {
+		if g.History[i] != m {
+			t.Errorf("Move %d = %+v, want %+v", i+1, g.History[i], m)
+		}
+	}
+	if g.CurrentPlayer != game.Player2 || g.Next != (game.Position{Row: 2, Col: 1}) {
+		t.Errorf("After the script: player %v sent to %v; want O sent to 2 1", g.CurrentPlayer, g.Next)
+	}
+}
+
+// TestRunUltimateGameEnds verifies the loop stops once the meta board is won
+func TestRunUltimateGameEnds(t *testing.T) {
+	g := ultimate.NewGame()
+	for _, col := range []int{0, 1} {
+		g.Boards[0][col], _ = game.ParseBoard("XXX/OO./...")
+		g.States[0][col] = game.Player1Won
+		g.Meta = g.Meta.SetCell(0, col, game.X)
+	}
+	g.Boards[0][2], _ = game.ParseBoard("XX./OO./...")
+	g.Next, g.AnyBoard = game.Position{Row: 0, Col: 2}, false
+
+	// The second line would be rejected as the game is over, so it must not be read
+	scanner := bufio.NewScanner(strings.NewReader("0 2\n1 1\n"))
+	g = runUltimateGame(g, scanner)
+
+	if g.State != game.Player1Won {
+		t.Errorf("State = %v, want Player1Won", g.State)
+	}
+	if !scanner.Scan() || scanner.Text() != "1 1" {
+		t.Error("runUltimateGame() read past the end of the game")
+	}
+}
+
+// TestUltimatePrompt verifies the prompt names the board the player is sent to
+func TestUltimatePrompt(t *testing.T) {
+	g := ultimate.NewGame()
+	if prompt := ultimatePrompt(g); !strings.Contains(prompt, "any open board") {
+		t.Errorf("First prompt = %q, want it to offer any board", prompt)
+	}
+
+	g, _ = g.MakeMove(game.Position{Row: 1, Col: 1}, game.Position{Row: 0, Col: 2})
+	if prompt := ultimatePrompt(g); !strings.Contains(prompt, "Player 2 (O), play in board 0 2") {
+		t.Errorf("Second prompt = %q, want O sent to board 0 2", prompt)
+	}
+}
+
+// TestRenderUltimate verifies marks, playable cells and the meta board are drawn
+func TestRenderUltimate(t *testing.T) {
+	g := ultimate.NewGame()
+	g.Boards[0][0], _ = game.ParseBoard("XXX/OO./...")
+	g.States[0][0] = game.Player1Won
+	g.Meta = g.Meta.SetCell(0, 0, game.X)
+	g.States[2][2] = game.Draw
+	g.CurrentPlayer, g.Next, g.AnyBoard = game.Player2, game.Position{Row: 1, Col: 1}, false
+
+	want := strings.Join([]string{
+		"         0       1       2        Meta",
+		"       0 1 2   0 1 2   0 1 2",
+		" 0  0  X X X |       |",
+		"    1  O O   |       |            X . .",
+		"    2        |       |",
+		"      -------+-------+-------",
+		" 1  0        | . . . |",
+		"    1        | . . . |            . . .",
+		"    2        | . . . |",
+		"      -------+-------+-------",
+		" 2  0        |       |",
+		"    1        |       |            . . -",
+		"    2        |       |",
+		"",
+	}, "\n")
+	if got := renderUltimate(g); got != want {
+		t.Errorf("renderUltimate() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -18,15 +18,36 @@ var (
 
 	// ErrIncompleteInput indicates input doesn't contain two numbers
 	ErrIncompleteInput = errors.New("Incomplete input. Please enter two numbers separated by space")
+
+	// ErrInvalidMark indicates the mark of a wild move is neither X nor O
+	ErrInvalidMark = errors.New("Invalid mark. Please start the move with X or O")
 )
 
 const (
 	// MinCoordinate is the minimum valid board coordinate
 	MinCoordinate = 0
-	// MaxCoordinate is the maximum valid board coordinate (3x3 board)
+	// MaxCoordinate is the maximum valid board coordinate on the classic 3x3 board
+	// Use ValidateRangeSize for boards of other sizes
 	MaxCoordinate = 2
 )
 
+// RangeError reports coordinates outside a board of a specific size
+// It matches ErrInvalidRange with errors.Is
+type RangeError struct {
+	Width  int // Number of columns on the board
+	Height int // Number of rows on the board
+}
+
+func (e *RangeError) Error() string {
+	return fmt.Sprintf("Invalid position. Row must be between 0 and %d and column between 0 and %d",
+		e.Height-1, e.Width-1)
+}
+
+// Is reports whether target is ErrInvalidRange
+func (e *RangeError) Is(target error) bool {
+	return target == ErrInvalidRange
+}
+
 // ValidateRange checks if row and column are within valid board range [0-2]
 // Returns ErrInvalidRange if coordinates are out of bounds
 func ValidateRange(row, col int) error {
@@ -39,6 +60,15 @@ func ValidateRange(row, col int) error {
 	return nil
 }
 
+// ValidateRangeSize checks if row and column are within a width x height board
+// Returns a *RangeError, which matches ErrInvalidRange, if coordinates are out of bounds
+func ValidateRangeSize(row, col, width, height int) error {
+	if row < MinCoordinate || row >= height || col < MinCoordinate || col >= width {
+		return &RangeError{Width: width, Height: height}
+	}
+	return nil
+}
+
 // ValidateNumeric parses a string into an integer
 // Returns ErrInvalidFormat if the string is not a valid number
 func ValidateNumeric(input string) (int, error) {
@@ -107,3 +137,40 @@ func ParseAndValidateInput(input string) (int, int, error) {
 
 	return row, col, nil
 }
+
+// ParseAndValidateInputSize is the validation pipeline for a width x height board
+// Returns validated row and column, or an error describing what went wrong
+func ParseAndValidateInputSize(input string, width, height int) (int, int, error) {
+	row, col, err := ValidateInputFormat(input)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if err := ValidateRangeSize(row, col, width, height); err != nil {
+		return 0, 0, err
+	}
+
+	return row, col, nil
+}
+
+// ParseMarkedInputSize is the validation pipeline for wild moves such as "X 1 1"
+// on a width x height board: a mark, X or O in either case, then row and column
+// Returns the mark in upper case with the validated row and column, or an error
+// describing what went wrong
+func ParseMarkedInputSize(input string, width, height int) (string, int, int, error) {
+	parts := strings.Fields(input)
+	if len(parts) < 3 {
+		return "", 0, 0, ErrIncompleteInput
+	}
+
+	mark := strings.ToUpper(parts[0])
+	if mark != "X" && mark != "O" {
+		return "", 0, 0, fmt.Errorf("%w: got %q", ErrInvalidMark, parts[0])
+	}
+
+	row, col, err := ParseAndValidateInputSize(strings.Join(parts[1:], " "), width, height)
+	if err != nil {
+		return "", 0, 0, err
+	}
+	return mark, row, col, nil
+}
@@ -248,3 +248,97 @@ func TestErrorMessages(t *testing.T) {
 		})
 	}
 }
+
+// TestValidateRangeSize verifies range validation for configurable board sizes
+func TestValidateRangeSize(t *testing.T) {
+	tests := []struct {
+		name      string
+		row       int
+		col       int
+		width     int
+		height    int
+		wantError bool
+	}{
+		{"Corner of 15x15", 14, 14, 15, 15, false},
+		{"Past corner of 15x15", 15, 14, 15, 15, true},
+		{"Wide board column", 0, 6, 7, 6, false},
+		{"Wide board row too large", 6, 0, 7, 6, true},
+		{"Negative column", 0, -1, 4, 4, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRangeSize(tt.row, tt.col, tt.width, tt.height)
+			if !tt.wantError {
+				if err != nil {
+					t.Errorf("ValidateRangeSize(%d, %d) unexpected error: %v", tt.row, tt.col, err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrInvalidRange) {
+				t.Errorf("ValidateRangeSize(%d, %d) error = %v, want ErrInvalidRange", tt.row, tt.col, err)
+			}
+			var rangeErr *RangeError
+			if !errors.As(err, &rangeErr) || rangeErr.Width != tt.width || rangeErr.Height != tt.height {
+				t.Errorf("ValidateRangeSize(%d, %d) error = %v, want *RangeError for %dx%d",
+					tt.row, tt.col, err, tt.width, tt.height)
+			}
+		})
+	}
+}
+
+// TestParseAndValidateInputSize verifies the pipeline on a larger board
+func TestParseAndValidateInputSize(t *testing.T) {
+	row, col, err := ParseAndValidateInputSize("10 12", 15, 15)
+	if err != nil {
+		t.Fatalf("ParseAndValidateInputSize() unexpected error: %v", err)
+	}
+	if row != 10 || col != 12 {
+		t.Errorf("ParseAndValidateInputSize() = (%d, %d), want (10, 12)", row, col)
+	}
+
+	if _, _, err := ParseAndValidateInputSize("3 3", 3, 3); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("ParseAndValidateInputSize(\"3 3\") error = %v, want ErrInvalidRange", err)
+	}
+	if _, _, err := ParseAndValidateInputSize("3", 4, 4); !errors.Is(err, ErrIncompleteInput) {
+		t.Errorf("ParseAndValidateInputSize(\"3\") error = %v, want ErrIncompleteInput", err)
+	}
+}
+
+// TestParseMarkedInputSize verifies wild moves are parsed into a mark, row and column
+func TestParseMarkedInputSize(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantMark string
+		wantRow  int
+		wantCol  int
+		wantErr  error
+	}{
+		{"Upper case X", "X 1 2", "X", 1, 2, nil},
+		{"Lower case o", "o 0 0", "O", 0, 0, nil},
+		{"Extra whitespace", "  x   2 1 ", "X", 2, 1, nil},
+		{"Missing mark", "1 1", "", 0, 0, ErrIncompleteInput},
+		{"Empty", "", "", 0, 0, ErrIncompleteInput},
+		{"Unknown mark", "Z 1 1", "", 0, 0, ErrInvalidMark},
+		{"Non-numeric row", "X a 1", "", 0, 0, ErrInvalidFormat},
+		{"Too many fields", "X 1 1 1", "", 0, 0, ErrInvalidFormat},
+		{"Off the board", "O 3 0", "", 0, 0, ErrInvalidRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mark, row, col, err := ParseMarkedInputSize(tt.input, 3, 3)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ParseMarkedInputSize(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || mark != tt.wantMark || row != tt.wantRow || col != tt.wantCol {
+				t.Errorf("ParseMarkedInputSize(%q) = %q, %d, %d, %v; want %q, %d, %d",
+					tt.input, mark, row, col, err, tt.wantMark, tt.wantRow, tt.wantCol)
+			}
+		})
+	}
+}